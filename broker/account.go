@@ -2,6 +2,7 @@ package broker
 
 import (
 	"context"
+	"iter"
 	"net/http"
 
 	"go.tradeforge.dev/alpaca/client"
@@ -16,6 +17,12 @@ const (
 	UpdateOnfidoSDKOutcomePath = "/v1/accounts/:account_id/onfido/sdk"
 	GetAccountHistoryPath      = "/v1/trading/accounts/:account_id/account/portfolio/history"
 	GetAccountTradingDetails   = "/v1/trading/accounts/:account_id/account"
+	ListActivitiesPath         = "/v1/accounts/activities"
+	ListActivitiesByTypePath   = "/v1/accounts/activities/:activity_type"
+	UpdateAccountPath          = "/v1/accounts/:account_id"
+	CloseAccountPath           = "/v1/accounts/:account_id/actions/close"
+	ReopenAccountPath          = "/v1/accounts/:account_id/actions/reopen"
+	TradingConfigurationsPath  = "/v1/trading/accounts/:account_id/account/configurations"
 )
 
 type AccountClient struct {
@@ -40,6 +47,35 @@ func (ac *AccountClient) GetAccount(ctx context.Context, params model.GetAccount
 	return res, err
 }
 
+// UpdateAccount updates the contact, identity, disclosures or trusted contact of the account.
+func (ac *AccountClient) UpdateAccount(ctx context.Context, params model.UpdateAccountParams, data *model.UpdateAccountRequest, opts ...model.RequestOption) (*model.UpdateAccountResponse, error) {
+	res := &model.UpdateAccountResponse{}
+	err := ac.Call(ctx, http.MethodPatch, UpdateAccountPath, params, res, append(opts, model.Body(data))...)
+	return res, err
+}
+
+// CloseAccount closes the account. The account must have no open positions and no cash.
+func (ac *AccountClient) CloseAccount(ctx context.Context, params model.CloseAccountParams, opts ...model.RequestOption) error {
+	return ac.Call(ctx, http.MethodPost, CloseAccountPath, params, nil, opts...)
+}
+
+// ReopenAccount reopens a closed account.
+func (ac *AccountClient) ReopenAccount(ctx context.Context, params model.ReopenAccountParams, opts ...model.RequestOption) error {
+	return ac.Call(ctx, http.MethodPost, ReopenAccountPath, params, nil, opts...)
+}
+
+func (ac *AccountClient) GetTradingConfigurations(ctx context.Context, params model.GetTradingConfigurationsParams, opts ...model.RequestOption) (*model.GetTradingConfigurationsResponse, error) {
+	res := &model.GetTradingConfigurationsResponse{}
+	err := ac.Call(ctx, http.MethodGet, TradingConfigurationsPath, params, res, opts...)
+	return res, err
+}
+
+func (ac *AccountClient) UpdateTradingConfigurations(ctx context.Context, params model.UpdateTradingConfigurationsParams, data *model.UpdateTradingConfigurationsRequest, opts ...model.RequestOption) (*model.UpdateTradingConfigurationsResponse, error) {
+	res := &model.UpdateTradingConfigurationsResponse{}
+	err := ac.Call(ctx, http.MethodPatch, TradingConfigurationsPath, params, res, append(opts, model.Body(data))...)
+	return res, err
+}
+
 func (ac *AccountClient) GetAccountTradingDetails(ctx context.Context, params model.GetAccountTradingDetailsParams, opts ...model.RequestOption) (*model.GetAccountTradingDetailsResponse, error) {
 	res := &model.GetAccountTradingDetailsResponse{}
 	err := ac.Call(ctx, http.MethodGet, GetAccountTradingDetails, params, res, opts...)
@@ -62,3 +98,61 @@ func (ac *AccountClient) UpdateOnfidoSDKOutcome(ctx context.Context, params mode
 	err := ac.Call(ctx, http.MethodPatch, UpdateOnfidoSDKOutcomePath, params, http.NoBody, append(opts, model.Body(data))...)
 	return err
 }
+
+// ListActivities returns the activities of all accounts, or of a single account if the account ID is set.
+// Every activity is either a *model.TradeActivity or a *model.NonTradeActivity.
+func (ac *AccountClient) ListActivities(ctx context.Context, params model.ListActivitiesParams, opts ...model.RequestOption) (model.ListActivitiesResponse, error) {
+	res := model.ListActivitiesResponse{}
+	err := ac.Call(ctx, http.MethodGet, ListActivitiesPath, params, &res, opts...)
+	return res, err
+}
+
+// ListActivitiesByType returns the activities of the given type.
+func (ac *AccountClient) ListActivitiesByType(ctx context.Context, params model.ListActivitiesByTypeParams, opts ...model.RequestOption) (model.ListActivitiesResponse, error) {
+	res := model.ListActivitiesResponse{}
+	err := ac.Call(ctx, http.MethodGet, ListActivitiesByTypePath, params, &res, opts...)
+	return res, err
+}
+
+// defaultActivitiesPageSize is the number of activities per page when the page size is not set.
+const defaultActivitiesPageSize = 100
+
+// IterActivities returns an iterator over the activities matching the params, following the page tokens.
+// Pages are fetched lazily; use model.WithPrefetch to fetch the next page in advance.
+func (ac *AccountClient) IterActivities(ctx context.Context, params model.ListActivitiesParams, opts ...model.RequestOption) iter.Seq2[model.Activity, error] {
+	return client.Paginate(
+		ctx,
+		params.PageToken,
+		func(ctx context.Context, pageToken *string) (client.Page[model.Activity, *string], error) {
+			params.PageToken = pageToken
+			res, err := ac.ListActivities(ctx, params, opts...)
+			if err != nil || len(res) == 0 {
+				return client.Page[model.Activity, *string]{}, err
+			}
+			pageSize := defaultActivitiesPageSize
+			if params.PageSize != nil {
+				pageSize = *params.PageSize
+			}
+			last := activityID(res[len(res)-1])
+			return client.Page[model.Activity, *string]{
+				Items: res,
+				Next:  &last,
+				// A short page is the last one. Paging also stops if the last activity has no ID,
+				// since an empty page token would restart from the first page.
+				HasNext: last != "" && len(res) >= pageSize,
+			}, nil
+		},
+		opts...,
+	)
+}
+
+func activityID(a model.Activity) string {
+	switch a := a.(type) {
+	case *model.TradeActivity:
+		return a.ID
+	case *model.NonTradeActivity:
+		return a.ID
+	default:
+		return ""
+	}
+}
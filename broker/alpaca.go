@@ -18,11 +18,15 @@ type Client struct {
 	*client.Client
 
 	AccountClient
+	DocumentClient
 	FundingClient
+	JournalClient
 	EventClient
 	OrderClient
 	MarketClient
+	OptionClient
 	TradingClient
+	WatchlistClient
 }
 
 // NewClient returns a new client with the specified API key and config.
@@ -36,12 +40,16 @@ func NewClient(
 	)
 	c.SetBasicAuth(config.APIKey, config.APISecret)
 	return &Client{
-		Client:        c,
-		AccountClient: AccountClient{Client: c},
-		FundingClient: FundingClient{Client: c},
-		EventClient:   EventClient{Client: c},
-		OrderClient:   OrderClient{Client: c},
-		MarketClient:  MarketClient{Client: c},
-		TradingClient: TradingClient{Client: c},
+		Client:          c,
+		AccountClient:   AccountClient{Client: c},
+		DocumentClient:  DocumentClient{Client: c},
+		FundingClient:   FundingClient{Client: c},
+		JournalClient:   JournalClient{Client: c},
+		EventClient:     EventClient{Client: c},
+		OrderClient:     OrderClient{Client: c},
+		MarketClient:    MarketClient{Client: c},
+		OptionClient:    OptionClient{Client: c},
+		TradingClient:   TradingClient{Client: c},
+		WatchlistClient: WatchlistClient{Client: c},
 	}
 }
@@ -1,24 +1,123 @@
 package broker
 
 import (
+	"bufio"
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strings"
+
+	"github.com/gabriel-vasile/mimetype"
 
 	"go.tradeforge.dev/alpaca/client"
 	"go.tradeforge.dev/alpaca/model"
+	"go.tradeforge.dev/alpaca/util"
 )
 
 const (
-	UploadDocumentPath = "/v1/accounts/:account_id/documents/upload"
+	UploadDocumentPath   = "/v1/accounts/:account_id/documents/upload"
+	ListDocumentsPath    = "/v1/accounts/:account_id/documents"
+	DownloadDocumentPath = "/v1/accounts/:account_id/documents/:document_id/download"
 )
 
+// mimeSniffSize is the number of bytes read to detect the MIME type of a document.
+const mimeSniffSize = 3072
+
 // DocumentClient is a client for the document API.
 type DocumentClient struct {
 	*client.Client
 }
 
-func (ac *AccountClient) UploadDocument(ctx context.Context, params model.UploadDocumentParams, data *model.UploadDocumentRequest, opts ...model.RequestOption) error {
+// UploadDocument uploads a base64 encoded document to the account.
+func (dc *DocumentClient) UploadDocument(ctx context.Context, params model.UploadDocumentParams, data *model.UploadDocumentRequest, opts ...model.RequestOption) error {
+	return dc.UploadDocuments(ctx, params, []*model.UploadDocumentRequest{data}, opts...)
+}
+
+// UploadDocuments uploads several base64 encoded documents to the account at once.
+func (dc *DocumentClient) UploadDocuments(ctx context.Context, params model.UploadDocumentParams, data []*model.UploadDocumentRequest, opts ...model.RequestOption) error {
+	res := &model.UploadDocumentResponse{}
+	return dc.Call(ctx, http.MethodPost, UploadDocumentPath, params, res, append(opts, model.Body(data))...)
+}
+
+// UploadDocumentStream uploads a document read from a reader to the account. The content is streamed and base64
+// encoded on the fly, so the document is never held in memory. The MIME type is detected from the content unless set.
+func (dc *DocumentClient) UploadDocumentStream(ctx context.Context, params model.UploadDocumentParams, data *model.UploadDocumentStreamRequest, opts ...model.RequestOption) error {
+	if data.Content == nil {
+		return errors.New("document content is required")
+	}
+	content := bufio.NewReaderSize(data.Content, mimeSniffSize)
+	mimeType := data.MimeType
+	if mimeType == nil {
+		head, err := content.Peek(mimeSniffSize)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("reading document: %w", err)
+		}
+		// Drop the parameters, such as the charset of text files.
+		detected, _, _ := strings.Cut(mimetype.Detect(head).String(), ";")
+		mimeType = util.AsPtr(detected)
+	}
+
+	pr, pw := io.Pipe()
+	// Closing the reader stops the writer if the request fails before the whole document has been sent.
+	defer pr.Close()
+	go func() {
+		pw.CloseWithError(writeDocumentUpload(pw, data, *mimeType, content))
+	}()
+
 	res := &model.UploadDocumentResponse{}
-	err := ac.Call(ctx, http.MethodPost, UploadDocumentPath, params, res, model.Body(data))
+	return dc.Call(ctx, http.MethodPost, UploadDocumentPath, params, res, append(opts, model.Body(io.Reader(pr)))...)
+}
+
+// writeDocumentUpload writes the JSON upload request of a single document, encoding the content as it is read.
+func writeDocumentUpload(w io.Writer, data *model.UploadDocumentStreamRequest, mimeType string, content io.Reader) error {
+	header, err := json.Marshal(model.UploadDocumentRequest{
+		DocumentType:    data.DocumentType,
+		DocumentSubType: data.DocumentSubType,
+		MimeType:        &mimeType,
+	})
+	if err != nil {
+		return fmt.Errorf("marshalling document: %w", err)
+	}
+	// The content is appended as the last field of the object: `[{...,"content":"<base64>"}]`.
+	if _, err := fmt.Fprintf(w, `[%s,"content":"`, header[:len(header)-1]); err != nil {
+		return err
+	}
+	enc := base64.NewEncoder(base64.StdEncoding, w)
+	if _, err := io.Copy(enc, content); err != nil {
+		return fmt.Errorf("encoding document: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("encoding document: %w", err)
+	}
+	_, err = io.WriteString(w, `"}]`)
 	return err
 }
+
+// ListDocuments returns the documents generated for the account, such as statements and trade confirmations.
+func (dc *DocumentClient) ListDocuments(ctx context.Context, params model.ListDocumentsParams, opts ...model.RequestOption) (model.ListDocumentsResponse, error) {
+	res := model.ListDocumentsResponse{}
+	err := dc.Call(ctx, http.MethodGet, ListDocumentsPath, params, &res, opts...)
+	return res, err
+}
+
+// ListTradeConfirmations returns the trade confirmations of the account.
+func (dc *DocumentClient) ListTradeConfirmations(ctx context.Context, params model.ListDocumentsParams, opts ...model.RequestOption) (model.ListDocumentsResponse, error) {
+	params.Type = util.AsPtr(model.AccountDocumentTypeTradeConfirmation)
+	return dc.ListDocuments(ctx, params, opts...)
+}
+
+// ListAccountStatements returns the monthly statements of the account.
+func (dc *DocumentClient) ListAccountStatements(ctx context.Context, params model.ListDocumentsParams, opts ...model.RequestOption) (model.ListDocumentsResponse, error) {
+	params.Type = util.AsPtr(model.AccountDocumentTypeAccountStatement)
+	return dc.ListDocuments(ctx, params, opts...)
+}
+
+// DownloadDocument returns the content of a document, such as a statement or a trade confirmation.
+// The API redirects to the file, which is followed. The caller must close the returned reader.
+func (dc *DocumentClient) DownloadDocument(ctx context.Context, params model.DownloadDocumentParams, opts ...model.RequestOption) (io.ReadCloser, error) {
+	return dc.Download(ctx, DownloadDocumentPath, params, opts...)
+}
@@ -14,6 +14,8 @@ const (
 	GetOrderEventsPath         = "/v2beta1/events/trades"
 	GetTransferEventPath       = "/v1/events/transfers/status"
 	GetAccountStatusEventsPath = "/v1/events/accounts/status"
+	GetJournalEventsPath       = "/v2beta1/events/journals/status"
+	GetNonTradeActivityPath    = "/v1/events/nta"
 )
 
 // EventClient defines a client for the Alpaca Broker Event API.
@@ -31,43 +33,35 @@ func (c *EventClient) ListenToAccountStatusUpdateEvents(ctx context.Context, par
 		ctx,
 		GetAccountStatusEventsPath,
 		params,
-		wrapAccountStatusUpdateHandler(handler),
+		decodeHandler(handler),
 		opts...,
 	)
 }
 
 // SubscribeToAccountStatusUpdateEvents subscribes to account status update SSE events.
 // The handler will be called for each event received.
-// This is a non-blocking call.
-func (c *EventClient) SubscribeToAccountStatusUpdateEvents(ctx context.Context, params model.WatchParams, handler AccountStatusUpdateHandler, opts ...model.RequestOption) error {
+// This is a non-blocking call. The returned subscription is used to stop the stream.
+func (c *EventClient) SubscribeToAccountStatusUpdateEvents(ctx context.Context, params model.WatchParams, handler AccountStatusUpdateHandler, opts ...model.RequestOption) (*client.Subscription, error) {
 	return c.Subscribe(
 		ctx,
 		GetAccountStatusEventsPath,
 		params,
-		wrapAccountStatusUpdateHandler(handler),
+		decodeHandler(handler),
 		opts...,
 	)
 }
 
-func wrapAccountStatusUpdateHandler(handler AccountStatusUpdateHandler) client.EventStreamHandler {
-	return func(ctx context.Context, event *sse.Event) error {
-		if event.IsComment() {
-			return nil
-		}
-		e, err := parseAccountStatusUpdateEvent(event)
-		if err != nil {
-			return fmt.Errorf("parsing account status event: %w", err)
-		}
-		return handler(ctx, e)
-	}
-}
-
-func parseAccountStatusUpdateEvent(event *sse.Event) (*model.AccountStatusUpdateEvent, error) {
-	e := model.AccountStatusUpdateEvent{}
-	if err := json.Unmarshal(event.Data, &e); err != nil {
-		return nil, fmt.Errorf("unmarshalling account status event: %w", err)
-	}
-	return &e, nil
+// StreamAccountStatusUpdateEvents streams account status update SSE events over a channel.
+// This is a non-blocking call.
+func (c *EventClient) StreamAccountStatusUpdateEvents(ctx context.Context, params model.WatchParams, options client.StreamOptions, opts ...model.RequestOption) (*client.Stream[model.AccountStatusUpdateEvent], error) {
+	return client.NewStream[model.AccountStatusUpdateEvent](
+		ctx,
+		c.Client,
+		GetAccountStatusEventsPath,
+		params,
+		options,
+		opts...,
+	)
 }
 
 type TransferStatusUpdateEventHandler func(ctx context.Context, event *model.TransferStatusUpdateEvent) error
@@ -80,43 +74,35 @@ func (c *EventClient) ListenToTransferEvents(ctx context.Context, params model.W
 		ctx,
 		GetTransferEventPath,
 		params,
-		wrapTransferEventHandler(handler),
+		decodeHandler(handler),
 		opts...,
 	)
 }
 
 // SubscribeToTransferEvents subscribes to transfer status update SSE events.
 // The handler will be called for each event received.
-// This is a non-blocking call.
-func (c *EventClient) SubscribeToTransferEvents(ctx context.Context, params model.WatchParams, handler TransferStatusUpdateEventHandler, opts ...model.RequestOption) error {
+// This is a non-blocking call. The returned subscription is used to stop the stream.
+func (c *EventClient) SubscribeToTransferEvents(ctx context.Context, params model.WatchParams, handler TransferStatusUpdateEventHandler, opts ...model.RequestOption) (*client.Subscription, error) {
 	return c.Subscribe(
 		ctx,
 		GetTransferEventPath,
 		params,
-		wrapTransferEventHandler(handler),
+		decodeHandler(handler),
 		opts...,
 	)
 }
 
-func wrapTransferEventHandler(handler TransferStatusUpdateEventHandler) client.EventStreamHandler {
-	return func(ctx context.Context, event *sse.Event) error {
-		if event.IsComment() {
-			return nil
-		}
-		e, err := parseTransferEvent(event)
-		if err != nil {
-			return fmt.Errorf("parsing transfer event: %w", err)
-		}
-		return handler(ctx, e)
-	}
-}
-
-func parseTransferEvent(event *sse.Event) (*model.TransferStatusUpdateEvent, error) {
-	e := model.TransferStatusUpdateEvent{}
-	if err := json.Unmarshal(event.Data, &e); err != nil {
-		return nil, fmt.Errorf("unmarshalling transfer event: %w", err)
-	}
-	return &e, nil
+// StreamTransferEvents streams transfer status update SSE events over a channel.
+// This is a non-blocking call.
+func (c *EventClient) StreamTransferEvents(ctx context.Context, params model.WatchParams, options client.StreamOptions, opts ...model.RequestOption) (*client.Stream[model.TransferStatusUpdateEvent], error) {
+	return client.NewStream[model.TransferStatusUpdateEvent](
+		ctx,
+		c.Client,
+		GetTransferEventPath,
+		params,
+		options,
+		opts...,
+	)
 }
 
 type OrderEventHandler func(ctx context.Context, event *model.OrderEvent) error
@@ -129,41 +115,130 @@ func (c *EventClient) ListenToOrderEvents(ctx context.Context, params model.Watc
 		ctx,
 		GetOrderEventsPath,
 		params,
-		wrapOrderEventHandler(handler),
+		decodeHandler(handler),
 		opts...,
 	)
 }
 
 // SubscribeToOrderEvents subscribes to order SSE events.
 // The handler will be called for each event received.
+// This is a non-blocking call. The returned subscription is used to stop the stream.
+func (c *EventClient) SubscribeToOrderEvents(ctx context.Context, params model.WatchParams, handler OrderEventHandler, opts ...model.RequestOption) (*client.Subscription, error) {
+	return c.Subscribe(
+		ctx,
+		GetOrderEventsPath,
+		params,
+		decodeHandler(handler),
+		opts...,
+	)
+}
+
+// StreamOrderEvents streams order SSE events over a channel.
 // This is a non-blocking call.
-func (c *EventClient) SubscribeToOrderEvents(ctx context.Context, params model.WatchParams, handler OrderEventHandler, opts ...model.RequestOption) error {
-	return c.Listen(
+func (c *EventClient) StreamOrderEvents(ctx context.Context, params model.WatchParams, options client.StreamOptions, opts ...model.RequestOption) (*client.Stream[model.OrderEvent], error) {
+	return client.NewStream[model.OrderEvent](
 		ctx,
+		c.Client,
 		GetOrderEventsPath,
 		params,
-		wrapOrderEventHandler(handler),
+		options,
+		opts...,
+	)
+}
+
+type JournalStatusUpdateEventHandler func(ctx context.Context, event *model.JournalStatusUpdateEvent) error
+
+// ListenToJournalEvents listens to journal status update SSE events.
+// The handler will be called for each event received.
+// This is a blocking call.
+func (c *EventClient) ListenToJournalEvents(ctx context.Context, params model.WatchParams, handler JournalStatusUpdateEventHandler, opts ...model.RequestOption) error {
+	return c.Listen(
+		ctx,
+		GetJournalEventsPath,
+		params,
+		decodeHandler(handler),
+		opts...,
+	)
+}
+
+// SubscribeToJournalEvents subscribes to journal status update SSE events.
+// The handler will be called for each event received.
+// This is a non-blocking call. The returned subscription is used to stop the stream.
+func (c *EventClient) SubscribeToJournalEvents(ctx context.Context, params model.WatchParams, handler JournalStatusUpdateEventHandler, opts ...model.RequestOption) (*client.Subscription, error) {
+	return c.Subscribe(
+		ctx,
+		GetJournalEventsPath,
+		params,
+		decodeHandler(handler),
 		opts...,
 	)
 }
 
-func wrapOrderEventHandler(handler OrderEventHandler) client.EventStreamHandler {
+// StreamJournalEvents streams journal status update SSE events over a channel.
+// This is a non-blocking call.
+func (c *EventClient) StreamJournalEvents(ctx context.Context, params model.WatchParams, options client.StreamOptions, opts ...model.RequestOption) (*client.Stream[model.JournalStatusUpdateEvent], error) {
+	return client.NewStream[model.JournalStatusUpdateEvent](
+		ctx,
+		c.Client,
+		GetJournalEventsPath,
+		params,
+		options,
+		opts...,
+	)
+}
+
+type NonTradeActivityEventHandler func(ctx context.Context, event *model.NonTradeActivityEvent) error
+
+// ListenToNonTradeActivityEvents listens to non-trade activity SSE events.
+// The handler will be called for each event received.
+// This is a blocking call.
+func (c *EventClient) ListenToNonTradeActivityEvents(ctx context.Context, params model.WatchParams, handler NonTradeActivityEventHandler, opts ...model.RequestOption) error {
+	return c.Listen(
+		ctx,
+		GetNonTradeActivityPath,
+		params,
+		decodeHandler(handler),
+		opts...,
+	)
+}
+
+// SubscribeToNonTradeActivityEvents subscribes to non-trade activity SSE events.
+// The handler will be called for each event received.
+// This is a non-blocking call. The returned subscription is used to stop the stream.
+func (c *EventClient) SubscribeToNonTradeActivityEvents(ctx context.Context, params model.WatchParams, handler NonTradeActivityEventHandler, opts ...model.RequestOption) (*client.Subscription, error) {
+	return c.Subscribe(
+		ctx,
+		GetNonTradeActivityPath,
+		params,
+		decodeHandler(handler),
+		opts...,
+	)
+}
+
+// StreamNonTradeActivityEvents streams non-trade activity SSE events over a channel.
+// This is a non-blocking call.
+func (c *EventClient) StreamNonTradeActivityEvents(ctx context.Context, params model.WatchParams, options client.StreamOptions, opts ...model.RequestOption) (*client.Stream[model.NonTradeActivityEvent], error) {
+	return client.NewStream[model.NonTradeActivityEvent](
+		ctx,
+		c.Client,
+		GetNonTradeActivityPath,
+		params,
+		options,
+		opts...,
+	)
+}
+
+// decodeHandler returns an event stream handler that decodes the data of every event into T and passes it to
+// the handler. Comments are skipped.
+func decodeHandler[T any](handler func(context.Context, *T) error) client.EventStreamHandler {
 	return func(ctx context.Context, event *sse.Event) error {
 		if event.IsComment() {
 			return nil
 		}
-		e, err := parseOrderEvent(event)
-		if err != nil {
-			return fmt.Errorf("parsing order event: %w", err)
+		var e T
+		if err := json.Unmarshal(event.Data, &e); err != nil {
+			return fmt.Errorf("unmarshalling %T: %w", e, err)
 		}
-		return handler(ctx, e)
-	}
-}
-
-func parseOrderEvent(event *sse.Event) (*model.OrderEvent, error) {
-	e := model.OrderEvent{}
-	if err := json.Unmarshal(event.Data, &e); err != nil {
-		return nil, fmt.Errorf("unmarshalling order event: %w", err)
+		return handler(ctx, &e)
 	}
-	return &e, nil
 }
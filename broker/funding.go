@@ -14,6 +14,16 @@ const (
 	GetFundingDetailsPath    = "/v1beta/accounts/:account_id/funding_wallet/funding_details"
 	CreateInstantDepositPath = "/v1/instant_funding"
 	CreateSandBoxDepositPath = "/v1beta/demo/banking/funding"
+
+	CreateACHRelationshipPath = "/v1/accounts/:account_id/ach_relationships"
+	ListACHRelationshipsPath  = "/v1/accounts/:account_id/ach_relationships"
+	DeleteACHRelationshipPath = "/v1/accounts/:account_id/ach_relationships/:ach_relationship_id"
+	CreateBankPath            = "/v1/accounts/:account_id/recipient_banks"
+	ListBanksPath             = "/v1/accounts/:account_id/recipient_banks"
+	DeleteBankPath            = "/v1/accounts/:account_id/recipient_banks/:bank_id"
+	CreateTransferPath        = "/v1/accounts/:account_id/transfers"
+	ListTransfersPath         = "/v1/accounts/:account_id/transfers"
+	CancelTransferPath        = "/v1/accounts/:account_id/transfers/:transfer_id"
 )
 
 // FundingClient is a client for the broker account API.
@@ -50,3 +60,55 @@ func (fc *FundingClient) CreateInstantFundingRequest(ctx context.Context, data *
 	err := fc.Call(ctx, http.MethodPost, CreateInstantDepositPath, nil, res, append(opts, model.Body(data))...)
 	return res, err
 }
+
+// CreateACHRelationship links a bank account to the account for ACH transfers.
+func (fc *FundingClient) CreateACHRelationship(ctx context.Context, params model.CreateACHRelationshipParams, data *model.CreateACHRelationshipRequest, opts ...model.RequestOption) (*model.CreateACHRelationshipResponse, error) {
+	res := &model.CreateACHRelationshipResponse{}
+	err := fc.Call(ctx, http.MethodPost, CreateACHRelationshipPath, params, res, append(opts, model.Body(data))...)
+	return res, err
+}
+
+func (fc *FundingClient) ListACHRelationships(ctx context.Context, params model.ListACHRelationshipsParams, opts ...model.RequestOption) (model.ListACHRelationshipsResponse, error) {
+	res := model.ListACHRelationshipsResponse{}
+	err := fc.Call(ctx, http.MethodGet, ListACHRelationshipsPath, params, &res, opts...)
+	return res, err
+}
+
+func (fc *FundingClient) DeleteACHRelationship(ctx context.Context, params model.DeleteACHRelationshipParams, opts ...model.RequestOption) error {
+	return fc.Call(ctx, http.MethodDelete, DeleteACHRelationshipPath, params, nil, opts...)
+}
+
+// CreateBank links a recipient bank to the account for wire transfers.
+func (fc *FundingClient) CreateBank(ctx context.Context, params model.CreateBankParams, data *model.CreateBankRequest, opts ...model.RequestOption) (*model.CreateBankResponse, error) {
+	res := &model.CreateBankResponse{}
+	err := fc.Call(ctx, http.MethodPost, CreateBankPath, params, res, append(opts, model.Body(data))...)
+	return res, err
+}
+
+func (fc *FundingClient) ListBanks(ctx context.Context, params model.ListBanksParams, opts ...model.RequestOption) (model.ListBanksResponse, error) {
+	res := model.ListBanksResponse{}
+	err := fc.Call(ctx, http.MethodGet, ListBanksPath, params, &res, opts...)
+	return res, err
+}
+
+func (fc *FundingClient) DeleteBank(ctx context.Context, params model.DeleteBankParams, opts ...model.RequestOption) error {
+	return fc.Call(ctx, http.MethodDelete, DeleteBankPath, params, nil, opts...)
+}
+
+// CreateTransfer moves cash between the account and an ACH relationship or a recipient bank.
+func (fc *FundingClient) CreateTransfer(ctx context.Context, params model.CreateTransferParams, data *model.CreateTransferRequest, opts ...model.RequestOption) (*model.CreateTransferResponse, error) {
+	res := &model.CreateTransferResponse{}
+	err := fc.Call(ctx, http.MethodPost, CreateTransferPath, params, res, append(opts, model.Body(data))...)
+	return res, err
+}
+
+func (fc *FundingClient) ListTransfers(ctx context.Context, params model.ListTransfersParams, opts ...model.RequestOption) (model.ListTransfersResponse, error) {
+	res := model.ListTransfersResponse{}
+	err := fc.Call(ctx, http.MethodGet, ListTransfersPath, params, &res, opts...)
+	return res, err
+}
+
+// CancelTransfer cancels a transfer that has not been processed yet.
+func (fc *FundingClient) CancelTransfer(ctx context.Context, params model.CancelTransferParams, opts ...model.RequestOption) error {
+	return fc.Call(ctx, http.MethodDelete, CancelTransferPath, params, nil, opts...)
+}
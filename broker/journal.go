@@ -0,0 +1,56 @@
+package broker
+
+import (
+	"context"
+	"net/http"
+
+	"go.tradeforge.dev/alpaca/client"
+	"go.tradeforge.dev/alpaca/model"
+)
+
+const (
+	CreateJournalPath             = "/v1/journals"
+	CreateBatchJournalPath        = "/v1/journals/batch"
+	CreateReverseBatchJournalPath = "/v1/journals/reverse_batch"
+	ListJournalsPath              = "/v1/journals"
+	DeleteJournalPath             = "/v1/journals/:journal_id"
+)
+
+// JournalClient is a client for the broker journals API.
+type JournalClient struct {
+	*client.Client
+}
+
+// CreateJournal moves cash or securities between two accounts.
+func (jc *JournalClient) CreateJournal(ctx context.Context, data *model.CreateJournalRequest, opts ...model.RequestOption) (*model.CreateJournalResponse, error) {
+	res := &model.CreateJournalResponse{}
+	err := jc.Call(ctx, http.MethodPost, CreateJournalPath, nil, res, append(opts, model.Body(data))...)
+	return res, err
+}
+
+// CreateBatchJournal moves cash from a single account to many accounts.
+// The entries that could not be booked are returned with their ErrorMessage set.
+func (jc *JournalClient) CreateBatchJournal(ctx context.Context, data *model.CreateBatchJournalRequest, opts ...model.RequestOption) (model.CreateBatchJournalResponse, error) {
+	res := model.CreateBatchJournalResponse{}
+	err := jc.Call(ctx, http.MethodPost, CreateBatchJournalPath, nil, &res, append(opts, model.Body(data))...)
+	return res, err
+}
+
+// CreateReverseBatchJournal moves cash from many accounts to a single account.
+// The entries that could not be booked are returned with their ErrorMessage set.
+func (jc *JournalClient) CreateReverseBatchJournal(ctx context.Context, data *model.CreateReverseBatchJournalRequest, opts ...model.RequestOption) (model.CreateBatchJournalResponse, error) {
+	res := model.CreateBatchJournalResponse{}
+	err := jc.Call(ctx, http.MethodPost, CreateReverseBatchJournalPath, nil, &res, append(opts, model.Body(data))...)
+	return res, err
+}
+
+func (jc *JournalClient) ListJournals(ctx context.Context, params model.ListJournalsParams, opts ...model.RequestOption) (model.ListJournalsResponse, error) {
+	res := model.ListJournalsResponse{}
+	err := jc.Call(ctx, http.MethodGet, ListJournalsPath, params, &res, opts...)
+	return res, err
+}
+
+// DeleteJournal cancels a journal that is still pending.
+func (jc *JournalClient) DeleteJournal(ctx context.Context, params model.DeleteJournalParams, opts ...model.RequestOption) error {
+	return jc.Call(ctx, http.MethodDelete, DeleteJournalPath, params, nil, opts...)
+}
@@ -12,6 +12,9 @@ const (
 	ListAssetsPath     = "/v1/assets"
 	GetCalendarPath    = "/v1/calendar"
 	GetMarketClockPath = "/v1/clock"
+
+	ListAnnouncementsPath = "/v1/corporate_actions/announcements"
+	GetAnnouncementPath   = "/v1/corporate_actions/announcements/:announcement_id"
 )
 
 type MarketClient struct {
@@ -35,3 +38,16 @@ func (cc *MarketClient) GetMarketClock(ctx context.Context, opts ...model.Reques
 	err := cc.Call(ctx, http.MethodGet, GetMarketClockPath, nil, res, opts...)
 	return res, err
 }
+
+// ListAnnouncements returns the corporate action announcements of the given types in a date range.
+func (cc *MarketClient) ListAnnouncements(ctx context.Context, params model.ListAnnouncementsParams, opts ...model.RequestOption) (model.ListAnnouncementsResponse, error) {
+	res := model.ListAnnouncementsResponse{}
+	err := cc.Call(ctx, http.MethodGet, ListAnnouncementsPath, params, &res, opts...)
+	return res, err
+}
+
+func (cc *MarketClient) GetAnnouncement(ctx context.Context, params model.GetAnnouncementParams, opts ...model.RequestOption) (*model.GetAnnouncementResponse, error) {
+	res := &model.GetAnnouncementResponse{}
+	err := cc.Call(ctx, http.MethodGet, GetAnnouncementPath, params, res, opts...)
+	return res, err
+}
@@ -0,0 +1,71 @@
+package broker
+
+import (
+	"context"
+	"iter"
+	"net/http"
+
+	"go.tradeforge.dev/alpaca/client"
+	"go.tradeforge.dev/alpaca/model"
+)
+
+const (
+	ListOptionContractsPath   = "/v1/options/contracts"
+	GetOptionContractPath     = "/v1/options/contracts/:symbol_or_id"
+	CreateOptionsApprovalPath = "/v1/accounts/:account_id/options/approval"
+	ListOptionsApprovalsPath  = "/v1/accounts/options/approvals"
+)
+
+// OptionClient is a client for the broker options API.
+type OptionClient struct {
+	*client.Client
+}
+
+// ListOptionContracts returns the option contracts matching the params.
+// Only the contracts expiring until the next weekend are returned unless an expiration date is set.
+func (oc *OptionClient) ListOptionContracts(ctx context.Context, params model.ListOptionContractsParams, opts ...model.RequestOption) (*model.ListOptionContractsResponse, error) {
+	res := &model.ListOptionContractsResponse{}
+	err := oc.Call(ctx, http.MethodGet, ListOptionContractsPath, params, res, opts...)
+	return res, err
+}
+
+// IterOptionContracts returns an iterator over the option contracts matching the params, following the page tokens.
+// Pages are fetched lazily; use model.WithPrefetch to fetch the next page in advance.
+func (oc *OptionClient) IterOptionContracts(ctx context.Context, params model.ListOptionContractsParams, opts ...model.RequestOption) iter.Seq2[model.OptionContract, error] {
+	return client.Paginate(
+		ctx,
+		params.PageToken,
+		func(ctx context.Context, pageToken *string) (client.Page[model.OptionContract, *string], error) {
+			params.PageToken = pageToken
+			res, err := oc.ListOptionContracts(ctx, params, opts...)
+			if err != nil {
+				return client.Page[model.OptionContract, *string]{}, err
+			}
+			return client.Page[model.OptionContract, *string]{
+				Items:   res.OptionContracts,
+				Next:    res.NextPageToken,
+				HasNext: res.NextPageToken != nil && *res.NextPageToken != "",
+			}, nil
+		},
+		opts...,
+	)
+}
+
+func (oc *OptionClient) GetOptionContract(ctx context.Context, params model.GetOptionContractParams, opts ...model.RequestOption) (*model.GetOptionContractResponse, error) {
+	res := &model.GetOptionContractResponse{}
+	err := oc.Call(ctx, http.MethodGet, GetOptionContractPath, params, res, opts...)
+	return res, err
+}
+
+// CreateOptionsApproval requests an options trading level for the account.
+func (oc *OptionClient) CreateOptionsApproval(ctx context.Context, params model.CreateOptionsApprovalParams, data *model.CreateOptionsApprovalRequest, opts ...model.RequestOption) (*model.CreateOptionsApprovalResponse, error) {
+	res := &model.CreateOptionsApprovalResponse{}
+	err := oc.Call(ctx, http.MethodPost, CreateOptionsApprovalPath, params, res, append(opts, model.Body(data))...)
+	return res, err
+}
+
+func (oc *OptionClient) ListOptionsApprovals(ctx context.Context, params model.ListOptionsApprovalsParams, opts ...model.RequestOption) (model.ListOptionsApprovalsResponse, error) {
+	res := model.ListOptionsApprovalsResponse{}
+	err := oc.Call(ctx, http.MethodGet, ListOptionsApprovalsPath, params, &res, opts...)
+	return res, err
+}
@@ -2,18 +2,32 @@ package broker
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"iter"
 	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
 
 	"go.tradeforge.dev/alpaca/client"
 	"go.tradeforge.dev/alpaca/model"
 )
 
 const (
-	EstimateOrderPath = "/v1/trading/accounts/:account_id/orders/estimation"
-	CreateOrderPath   = "/v1/trading/accounts/:account_id/orders"
-	CancelOrderPath   = "/v1/trading/accounts/:account_id/orders/:order_id"
-	GetOrderPath      = "/v1/trading/accounts/:account_id/orders/:order_id"
-	ListOrdersPath    = "/v1/trading/accounts/:account_id/orders"
+	EstimateOrderPath           = "/v1/trading/accounts/:account_id/orders/estimation"
+	CreateOrderPath             = "/v1/trading/accounts/:account_id/orders"
+	ReplaceOrderPath            = "/v1/trading/accounts/:account_id/orders/:order_id"
+	CancelOrderPath             = "/v1/trading/accounts/:account_id/orders/:order_id"
+	CancelAllOrdersPath         = "/v1/trading/accounts/:account_id/orders"
+	GetOrderPath                = "/v1/trading/accounts/:account_id/orders/:order_id"
+	GetOrderByClientOrderIDPath = "/v1/trading/accounts/:account_id/orders:by_client_order_id"
+	ListOrdersPath              = "/v1/trading/accounts/:account_id/orders"
+
+	// createOrdersConcurrency is the maximum number of orders submitted at once by CreateOrders.
+	createOrdersConcurrency = 8
 )
 
 type OrderClient struct {
@@ -32,10 +46,69 @@ func (oc *OrderClient) CreateOrder(ctx context.Context, params model.CreateOrder
 	return res, err
 }
 
+// CreateOrderResult is the outcome of the submission of a single order within CreateOrders.
+type CreateOrderResult struct {
+	Request *model.CreateOrderRequest
+	Order   *model.CreateOrderResponse
+	Err     error
+}
+
+// CreateOrders submits the orders concurrently and returns one result per order, in the order of the requests.
+// A failed submission does not stop the others. The returned error joins the errors of all failed submissions.
+func (oc *OrderClient) CreateOrders(ctx context.Context, params model.CreateOrderParams, data []*model.CreateOrderRequest, opts ...model.RequestOption) ([]CreateOrderResult, error) {
+	// CreateOrder appends the body to the options, so they must not share spare capacity between goroutines.
+	opts = slices.Clip(opts)
+	results := make([]CreateOrderResult, len(data))
+	sem := make(chan struct{}, createOrdersConcurrency)
+	var wg sync.WaitGroup
+	for i, req := range data {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				results[i] = CreateOrderResult{Request: req, Err: ctx.Err()}
+				return
+			}
+			res, err := oc.CreateOrder(ctx, params, req, opts...)
+			if err != nil {
+				results[i] = CreateOrderResult{Request: req, Err: fmt.Errorf("creating order %q: %w", req.ClientOrderID, err)}
+				return
+			}
+			results[i] = CreateOrderResult{Request: req, Order: res}
+		}()
+	}
+	wg.Wait()
+
+	errs := make([]error, 0, len(results))
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, r.Err)
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+func (oc *OrderClient) ReplaceOrder(ctx context.Context, params model.ReplaceOrderParams, data *model.ReplaceOrderRequest, opts ...model.RequestOption) (*model.ReplaceOrderResponse, error) {
+	res := &model.ReplaceOrderResponse{}
+	err := oc.Call(ctx, http.MethodPatch, ReplaceOrderPath, params, res, append(opts, model.Body(data))...)
+	return res, err
+}
+
 func (oc *OrderClient) CancelOrder(ctx context.Context, params model.CancelOrderParams, opts ...model.RequestOption) error {
 	return oc.Call(ctx, http.MethodDelete, CancelOrderPath, params, nil, opts...)
 }
 
+// CancelAllOrders requests the cancellation of all open orders of the account.
+// The result holds the status of the cancellation of each order.
+func (oc *OrderClient) CancelAllOrders(ctx context.Context, params model.CancelAllOrdersParams, opts ...model.RequestOption) (model.CancelAllOrdersResponse, error) {
+	res := model.CancelAllOrdersResponse{}
+	err := oc.Call(ctx, http.MethodDelete, CancelAllOrdersPath, params, &res, opts...)
+	return res, err
+}
+
 func (oc *OrderClient) ListOrders(ctx context.Context, params model.ListOrdersParams, opts ...model.RequestOption) (model.ListOrdersResponse, error) {
 	res := model.ListOrdersResponse{}
 	err := oc.Call(ctx, http.MethodGet, ListOrdersPath, params, &res, opts...)
@@ -47,3 +120,91 @@ func (oc *OrderClient) GetOrder(ctx context.Context, params model.GetOrderParams
 	err := oc.Call(ctx, http.MethodGet, GetOrderPath, params, res, opts...)
 	return res, err
 }
+
+func (oc *OrderClient) GetOrderByClientOrderID(ctx context.Context, params model.GetOrderByClientOrderIDParams, opts ...model.RequestOption) (*model.GetOrderResponse, error) {
+	res := &model.GetOrderResponse{}
+	err := oc.Call(ctx, http.MethodGet, GetOrderByClientOrderIDPath, params, res, opts...)
+	return res, err
+}
+
+// IterOrders returns an iterator over the orders matching the params.
+// The orders are listed page by page by moving the After (ascending direction) or Until (descending direction)
+// boundary of the time window to the submission time of the last order of the previous page.
+// Pages are fetched lazily; use model.WithPrefetch to fetch the next page in advance.
+func (oc *OrderClient) IterOrders(ctx context.Context, params model.ListOrdersParams, opts ...model.RequestOption) iter.Seq2[model.Order, error] {
+	if params.Limit <= 0 || params.Limit > model.ListOrdersMaxLimit {
+		params.Limit = model.ListOrdersMaxLimit
+	}
+	asc := params.Direction == model.SortDirectionAsc
+
+	return client.Paginate(
+		ctx,
+		orderCursor{},
+		func(ctx context.Context, cursor orderCursor) (client.Page[model.Order, orderCursor], error) {
+			pageParams := params
+			if !cursor.boundary.IsZero() {
+				// Time filters are sent with a precision of one second, so the window overlaps the second
+				// of the last order and the orders already yielded within that second are skipped.
+				if asc {
+					pageParams.After = cursor.boundary
+				} else {
+					pageParams.Until = cursor.boundary.Add(time.Second)
+				}
+			}
+			orders, err := oc.ListOrders(ctx, pageParams, opts...)
+			if err != nil {
+				return client.Page[model.Order, orderCursor]{}, err
+			}
+
+			next := cursor.clone()
+			items := make([]model.Order, 0, len(orders))
+			for _, o := range orders {
+				if _, ok := next.seen[o.ID]; ok {
+					continue
+				}
+				items = append(items, o)
+				next.advance(o)
+			}
+			hasNext := len(orders) == pageParams.Limit
+			if hasNext && len(items) == 0 {
+				// A full page made only of already yielded orders cannot move the window forward.
+				return client.Page[model.Order, orderCursor]{}, fmt.Errorf("more than %d orders submitted within one second", pageParams.Limit)
+			}
+			return client.Page[model.Order, orderCursor]{
+				Items:   items,
+				Next:    next,
+				HasNext: hasNext,
+			}, nil
+		},
+		opts...,
+	)
+}
+
+// orderCursor is the time window boundary of the next order page.
+type orderCursor struct {
+	// boundary is the submission time of the last yielded order, truncated to the second.
+	boundary time.Time
+	// seen are the IDs of the orders already yielded within the boundary second.
+	seen map[uuid.UUID]struct{}
+}
+
+func (c orderCursor) clone() orderCursor {
+	seen := make(map[uuid.UUID]struct{}, len(c.seen))
+	for id := range c.seen {
+		seen[id] = struct{}{}
+	}
+	return orderCursor{boundary: c.boundary, seen: seen}
+}
+
+func (c *orderCursor) advance(o model.Order) {
+	t := o.CreatedAt
+	if o.SubmittedAt != nil {
+		t = *o.SubmittedAt
+	}
+	t = t.Truncate(time.Second)
+	if !t.Equal(c.boundary) {
+		c.boundary = t
+		clear(c.seen)
+	}
+	c.seen[o.ID] = struct{}{}
+}
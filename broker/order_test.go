@@ -0,0 +1,205 @@
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go.tradeforge.dev/alpaca/client"
+	"go.tradeforge.dev/alpaca/model"
+)
+
+// orderServer lists the orders like the API: the after and until filters have a precision of one second
+// and are exclusive, and the orders are sorted by submission time in the requested direction.
+// Orders submitted at the same time are always listed in the same order.
+func orderServer(t *testing.T, orders []model.Order) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		query := r.URL.Query()
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		after, _ := time.Parse(time.RFC3339, query.Get("after"))
+		until, _ := time.Parse(time.RFC3339, query.Get("until"))
+
+		var page []model.Order
+		for _, o := range orders {
+			if !after.IsZero() && !o.SubmittedAt.After(after) {
+				continue
+			}
+			if !until.IsZero() && !o.SubmittedAt.Before(until) {
+				continue
+			}
+			page = append(page, o)
+		}
+		desc := query.Get("direction") == model.SortDirectionDesc
+		slices.SortStableFunc(page, func(a, b model.Order) int {
+			if desc {
+				return b.SubmittedAt.Compare(*a.SubmittedAt)
+			}
+			return a.SubmittedAt.Compare(*b.SubmittedAt)
+		})
+		if len(page) > limit {
+			page = page[:limit]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func newTestOrderClient(url string) *OrderClient {
+	return &OrderClient{Client: client.New(url, slog.New(slog.NewTextHandler(io.Discard, nil)))}
+}
+
+// testOrders returns orders named after their submission offset from a whole second, e.g. "1.5" for 1.5s.
+// Names may carry a suffix to submit several orders at the same time, e.g. "1a" and "1b".
+func testOrders(names ...string) []model.Order {
+	start := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	orders := make([]model.Order, len(names))
+	for i, name := range names {
+		offset, _ := strconv.ParseFloat(strings.TrimRight(name, "abcdef"), 64)
+		submittedAt := start.Add(time.Duration(offset * float64(time.Second)))
+		orders[i] = model.Order{
+			ID:          uuid.New(),
+			Symbol:      name,
+			CreatedAt:   submittedAt,
+			SubmittedAt: &submittedAt,
+		}
+	}
+	return orders
+}
+
+func symbols(orders []model.Order) []string {
+	names := make([]string, len(orders))
+	for i, o := range orders {
+		names[i] = o.Symbol
+	}
+	return names
+}
+
+func TestIterOrders(t *testing.T) {
+	tests := []struct {
+		name      string
+		orders    []string
+		limit     int
+		direction string
+		prefetch  bool
+	}{
+		{
+			name:      "ascending",
+			orders:    []string{"0.1", "1.1", "2.1", "3.1", "4.1"},
+			limit:     2,
+			direction: model.SortDirectionAsc,
+		},
+		{
+			name:      "ascending with orders sharing the boundary time",
+			orders:    []string{"0.1", "0.2", "1.5a", "1.5b", "2.5", "3.5"},
+			limit:     3,
+			direction: model.SortDirectionAsc,
+		},
+		{
+			name:      "ascending with orders sharing the boundary second",
+			orders:    []string{"0.5", "0.6", "1.1", "1.2", "2.1", "3.5"},
+			limit:     3,
+			direction: model.SortDirectionAsc,
+		},
+		{
+			name:      "ascending with prefetch",
+			orders:    []string{"0.5", "0.6", "1.5a", "1.5b", "2.1", "2.2", "3.5", "4.5"},
+			limit:     3,
+			direction: model.SortDirectionAsc,
+			prefetch:  true,
+		},
+		{
+			name:      "descending",
+			orders:    []string{"4.1", "3.1", "2.1", "1.1", "0.1"},
+			limit:     2,
+			direction: model.SortDirectionDesc,
+		},
+		{
+			name:      "descending with orders sharing the boundary time",
+			orders:    []string{"3.5", "2.5", "1.5a", "1.5b", "0.1"},
+			limit:     3,
+			direction: model.SortDirectionDesc,
+		},
+		{
+			name:      "descending with orders sharing a whole second",
+			orders:    []string{"3", "2.5", "1a", "1b", "0.5"},
+			limit:     3,
+			direction: model.SortDirectionDesc,
+			prefetch:  true,
+		},
+		{
+			name:      "last page is full",
+			orders:    []string{"0.1", "1.1", "2.1", "3.1"},
+			limit:     2,
+			direction: model.SortDirectionAsc,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, _ := orderServer(t, testOrders(tt.orders...))
+			oc := newTestOrderClient(srv.URL)
+
+			var got []model.Order
+			params := model.ListOrdersParams{AccountID: "account", Limit: tt.limit, Direction: tt.direction}
+			for o, err := range oc.IterOrders(context.Background(), params, model.WithPrefetch(tt.prefetch)) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				got = append(got, o)
+			}
+			if names := symbols(got); !slices.Equal(names, tt.orders) {
+				t.Errorf("got orders %v, want %v", names, tt.orders)
+			}
+		})
+	}
+}
+
+func TestIterOrdersTooManyWithinWindow(t *testing.T) {
+	srv, _ := orderServer(t, testOrders("1.1a", "1.1b", "1.1c"))
+	oc := newTestOrderClient(srv.URL)
+
+	params := model.ListOrdersParams{AccountID: "account", Limit: 2, Direction: model.SortDirectionDesc}
+	var n int
+	var iterErr error
+	for _, err := range oc.IterOrders(context.Background(), params) {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		n++
+	}
+	if iterErr == nil {
+		t.Fatal("expected an error")
+	}
+	if n != 2 {
+		t.Errorf("got %d orders before the error, want 2", n)
+	}
+}
+
+func TestIterOrdersStopsEarly(t *testing.T) {
+	srv, requests := orderServer(t, testOrders("0.1", "1.1", "2.1", "3.1", "4.1"))
+	oc := newTestOrderClient(srv.URL)
+
+	params := model.ListOrdersParams{AccountID: "account", Limit: 2, Direction: model.SortDirectionAsc}
+	for range oc.IterOrders(context.Background(), params) {
+		break
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("got %d requests, want 1", got)
+	}
+}
@@ -5,12 +5,16 @@ import (
 	"net/http"
 
 	"go.tradeforge.dev/alpaca/client"
+	alpacaerrors "go.tradeforge.dev/alpaca/errors"
 	"go.tradeforge.dev/alpaca/model"
 )
 
 const (
 	GetOpenPositionBySymbolPath = "/v1/trading/accounts/:account_id/positions/:symbol"
 	ListOpenPositionsPath       = "/v1/trading/accounts/:account_id/positions"
+	ClosePositionPath           = "/v1/trading/accounts/:account_id/positions/:symbol_or_asset_id"
+	CloseAllPositionsPath       = "/v1/trading/accounts/:account_id/positions"
+	ExerciseOptionPositionPath  = "/v1/trading/accounts/:account_id/positions/:symbol_or_contract_id/exercise"
 )
 
 type TradingClient struct {
@@ -20,6 +24,9 @@ type TradingClient struct {
 func (tc *TradingClient) GetOpenPositionBySymbol(ctx context.Context, params model.GetOpenPositionBySymbolParams, opts ...model.RequestOption) (*model.GetOpenPositionResponse, error) {
 	res := &model.GetOpenPositionResponse{}
 	err := tc.Call(ctx, http.MethodGet, GetOpenPositionBySymbolPath, params, res, opts...)
+	if responseError, ok := alpacaerrors.AsResponseError(err); ok && responseError.StatusCode == http.StatusNotFound {
+		return nil, alpacaerrors.NewPositionFoundError().Wrap(err)
+	}
 	return res, err
 }
 
@@ -28,3 +35,27 @@ func (tc *TradingClient) ListOpenPositions(ctx context.Context, params model.Lis
 	err := tc.Call(ctx, http.MethodGet, ListOpenPositionsPath, params, &res, opts...)
 	return res, err
 }
+
+// ClosePosition liquidates the position by placing a market order.
+// The whole position is closed unless a quantity or a percentage is set.
+// The request is never retried, as a repeated DELETE would place another liquidation order.
+func (tc *TradingClient) ClosePosition(ctx context.Context, params model.ClosePositionParams, opts ...model.RequestOption) (*model.ClosePositionResponse, error) {
+	res := &model.ClosePositionResponse{}
+	err := tc.Call(ctx, http.MethodDelete, ClosePositionPath, params, res, append(opts, model.NoRetry())...)
+	return res, err
+}
+
+// CloseAllPositions liquidates all positions of the account by placing market orders.
+// The result holds the status of the liquidation of each position, along with its order on success.
+// The request is never retried, as a repeated DELETE would place another liquidation order.
+func (tc *TradingClient) CloseAllPositions(ctx context.Context, params model.CloseAllPositionsParams, opts ...model.RequestOption) (model.CloseAllPositionsResponse, error) {
+	res := model.CloseAllPositionsResponse{}
+	err := tc.Call(ctx, http.MethodDelete, CloseAllPositionsPath, params, &res, append(opts, model.NoRetry())...)
+	return res, err
+}
+
+// ExerciseOptionPosition exercises all the contracts of a long option position.
+// The resulting shares or cash are booked asynchronously as a non-trade activity.
+func (tc *TradingClient) ExerciseOptionPosition(ctx context.Context, params model.ExerciseOptionPositionParams, opts ...model.RequestOption) error {
+	return tc.Call(ctx, http.MethodPost, ExerciseOptionPositionPath, params, nil, opts...)
+}
@@ -0,0 +1,66 @@
+package broker
+
+import (
+	"context"
+	"net/http"
+
+	"go.tradeforge.dev/alpaca/client"
+	"go.tradeforge.dev/alpaca/model"
+)
+
+const (
+	CreateWatchlistPath       = "/v1/trading/accounts/:account_id/watchlists"
+	ListWatchlistsPath        = "/v1/trading/accounts/:account_id/watchlists"
+	GetWatchlistPath          = "/v1/trading/accounts/:account_id/watchlists/:watchlist_id"
+	UpdateWatchlistPath       = "/v1/trading/accounts/:account_id/watchlists/:watchlist_id"
+	DeleteWatchlistPath       = "/v1/trading/accounts/:account_id/watchlists/:watchlist_id"
+	AddWatchlistSymbolPath    = "/v1/trading/accounts/:account_id/watchlists/:watchlist_id"
+	RemoveWatchlistSymbolPath = "/v1/trading/accounts/:account_id/watchlists/:watchlist_id/:symbol"
+)
+
+// WatchlistClient is a client for the broker watchlists API.
+type WatchlistClient struct {
+	*client.Client
+}
+
+func (wc *WatchlistClient) CreateWatchlist(ctx context.Context, params model.CreateWatchlistParams, data *model.CreateWatchlistRequest, opts ...model.RequestOption) (*model.CreateWatchlistResponse, error) {
+	res := &model.CreateWatchlistResponse{}
+	err := wc.Call(ctx, http.MethodPost, CreateWatchlistPath, params, res, append(opts, model.Body(data))...)
+	return res, err
+}
+
+// ListWatchlists returns the watchlists of the account, without their assets.
+func (wc *WatchlistClient) ListWatchlists(ctx context.Context, params model.ListWatchlistsParams, opts ...model.RequestOption) (model.ListWatchlistsResponse, error) {
+	res := model.ListWatchlistsResponse{}
+	err := wc.Call(ctx, http.MethodGet, ListWatchlistsPath, params, &res, opts...)
+	return res, err
+}
+
+func (wc *WatchlistClient) GetWatchlist(ctx context.Context, params model.GetWatchlistParams, opts ...model.RequestOption) (*model.GetWatchlistResponse, error) {
+	res := &model.GetWatchlistResponse{}
+	err := wc.Call(ctx, http.MethodGet, GetWatchlistPath, params, res, opts...)
+	return res, err
+}
+
+func (wc *WatchlistClient) UpdateWatchlist(ctx context.Context, params model.UpdateWatchlistParams, data *model.UpdateWatchlistRequest, opts ...model.RequestOption) (*model.UpdateWatchlistResponse, error) {
+	res := &model.UpdateWatchlistResponse{}
+	err := wc.Call(ctx, http.MethodPut, UpdateWatchlistPath, params, res, append(opts, model.Body(data))...)
+	return res, err
+}
+
+func (wc *WatchlistClient) DeleteWatchlist(ctx context.Context, params model.DeleteWatchlistParams, opts ...model.RequestOption) error {
+	return wc.Call(ctx, http.MethodDelete, DeleteWatchlistPath, params, nil, opts...)
+}
+
+// AddWatchlistSymbol appends a symbol to the watchlist.
+func (wc *WatchlistClient) AddWatchlistSymbol(ctx context.Context, params model.AddWatchlistSymbolParams, data *model.AddWatchlistSymbolRequest, opts ...model.RequestOption) (*model.AddWatchlistSymbolResponse, error) {
+	res := &model.AddWatchlistSymbolResponse{}
+	err := wc.Call(ctx, http.MethodPost, AddWatchlistSymbolPath, params, res, append(opts, model.Body(data))...)
+	return res, err
+}
+
+func (wc *WatchlistClient) RemoveWatchlistSymbol(ctx context.Context, params model.RemoveWatchlistSymbolParams, opts ...model.RequestOption) (*model.RemoveWatchlistSymbolResponse, error) {
+	res := &model.RemoveWatchlistSymbolResponse{}
+	err := wc.Call(ctx, http.MethodDelete, RemoveWatchlistSymbolPath, params, res, opts...)
+	return res, err
+}
@@ -1,10 +1,8 @@
 package client
 
 import (
-	"bufio"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -14,7 +12,6 @@ import (
 	"go.tradeforge.dev/alpaca/encoder"
 	alpacaerrors "go.tradeforge.dev/alpaca/errors"
 	"go.tradeforge.dev/alpaca/model"
-	"go.tradeforge.dev/alpaca/sse"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -29,8 +26,12 @@ const (
 type Client struct {
 	HTTP *resty.Client
 
-	encoder *encoder.Encoder
-	logger  *slog.Logger
+	encoder         *encoder.Encoder
+	logger          *slog.Logger
+	retryPolicy     RetryPolicy
+	reconnectPolicy ReconnectPolicy
+	rateLimiter     *RateLimiter
+	middlewares     []Middleware
 }
 
 // New returns a new client with the specified API key and config.
@@ -51,9 +52,12 @@ func newClient(
 	c.SetHeader("Accept", "application/json")
 
 	return &Client{
-		HTTP:    c,
-		encoder: encoder.New(),
-		logger:  logger,
+		HTTP:            c,
+		encoder:         encoder.New(),
+		logger:          logger,
+		retryPolicy:     DefaultRetryPolicy(),
+		reconnectPolicy: DefaultReconnectPolicy(),
+		rateLimiter:     NewRateLimiter(0, DefaultRateLimitWindow),
 	}
 }
 
@@ -78,19 +82,39 @@ func (c *Client) Call(ctx context.Context, method, path string, params, response
 
 // CallURL makes an API call based on a request URI and options. The response is automatically unmarshaled.
 func (c *Client) CallURL(ctx context.Context, method, uri string, response any, opts ...model.RequestOption) error {
-	options := mergeOptions(opts...)
+	req := &Request{
+		Method:  method,
+		URI:     uri,
+		Options: mergeOptions(opts...),
+	}
+	res, err := c.roundTrip(ctx, req, func(ctx context.Context, req *Request) (*resty.Response, error) {
+		return c.call(ctx, req, response)
+	})
+	if response == nil || response == http.NoBody {
+		discardBody(res)
+	}
+	if err != nil {
+		return err
+	}
+	return nil
+}
 
-	c.HTTP.SetTimeout(DefaultClientTimeout)
-	req := c.HTTP.R().SetContext(ctx)
-	if options.Body != nil {
-		b, err := json.Marshal(options.Body)
+func (c *Client) call(ctx context.Context, r *Request, response any) (*resty.Response, error) {
+	req := c.HTTP.R()
+	switch body := r.Options.Body.(type) {
+	case nil:
+	case io.Reader:
+		// Readers are streamed as is. They can only be read once, so such requests are never retried.
+		req.SetBody(body)
+	default:
+		b, err := json.Marshal(body)
 		if err != nil {
-			return fmt.Errorf("failed to marshal body: %w", err)
+			return nil, fmt.Errorf("failed to marshal body: %w", err)
 		}
 		req.SetBody(b)
 	}
-	req.SetQueryParamsFromValues(options.QueryParams)
-	req.SetHeaderMultiValues(options.Headers)
+	req.SetQueryParamsFromValues(r.Options.QueryParams)
+	req.SetHeaderMultiValues(r.Options.Headers)
 	if response == nil || response == http.NoBody {
 		req.SetDoNotParseResponse(true)
 	} else {
@@ -99,11 +123,12 @@ func (c *Client) CallURL(ctx context.Context, method, uri string, response any,
 	req.SetError(&alpacaerrors.ResponseError{})
 	req.SetHeader("Content-Type", "application/json")
 
-	_, err := c.executeRequest(ctx, req, method, uri, options.Trace)
-	if err != nil {
-		return err
+	timeout := DefaultClientTimeout
+	if _, ok := r.Options.Body.(io.Reader); ok {
+		// Streamed uploads may take longer than the client timeout, so they are only bound to the context.
+		timeout = 0
 	}
-	return nil
+	return c.executeWithRetry(ctx, req, r.Method, r.URI, r.Options, timeout)
 }
 
 func (c *Client) executeRequest(
@@ -126,16 +151,23 @@ func (c *Client) executeRequest(
 				slog.Int("status", responseError.StatusCode),
 				slog.String("error message", responseError.Message),
 			)
-		} else {
-			c.logger.Error(
-				"response error",
-				slog.String("url", uri),
-				slog.Int("status", res.StatusCode()),
-				slog.String("error message", res.Status()),
-				slog.String("response", string(res.Body())),
-			)
+			return res, responseError
+		}
+		c.logger.Error(
+			"response error",
+			slog.String("url", uri),
+			slog.Int("status", res.StatusCode()),
+			slog.String("error message", res.Status()),
+			slog.String("response", string(res.Body())),
+		)
+		// The response body was not parsed (e.g. a raw stream), so only the status is known.
+		return res, &alpacaerrors.ResponseError{
+			BaseResponse: model.BaseResponse{
+				RequestID: res.Header().Get("X-Request-ID"),
+				Message:   res.Status(),
+			},
+			StatusCode: res.StatusCode(),
 		}
-		return res, responseError
 	}
 
 	if trace {
@@ -155,169 +187,6 @@ func (c *Client) executeRequest(
 	return res, nil
 }
 
-type EventStreamHandler func(ctx context.Context, event *sse.Event) error
-
-// Listen to an event data stream.
-// This is a blocking call that will continue to read from the stream until the context is canceled
-// or the watch is stopped.
-//
-// NOTE: The event reader should not be shared between multiple listeners, otherwise, there might be unexpected parsing results.
-func (c *Client) Listen(ctx context.Context, path string, params any, handler EventStreamHandler, opts ...model.RequestOption) error {
-	cancellableContext, cancel := context.WithCancel(ctx)
-
-	r, err := c.listenToSSE(cancellableContext, path, params, opts...)
-	if err != nil {
-		cancel()
-		return fmt.Errorf("initializing SSE stream: %w", err)
-	}
-	defer func() {
-		if err := r.Close(); err != nil {
-			c.logger.Error("closing stream", slog.Any("error", err))
-		}
-		cancel()
-	}()
-
-	evtChannel, errChannel := make(chan *sse.Event, 1), make(chan error, 1)
-	go c.startReadingSSE(cancellableContext, r, evtChannel, errChannel)
-	for {
-		select {
-		case <-ctx.Done():
-			c.logger.Error("context cancelled", slog.Any("error", ctx.Err()))
-			return nil
-		case err := <-errChannel:
-			c.logger.Error("reading from stream", slog.Any("error", err))
-			return err
-		case event := <-evtChannel:
-			if event.Retry != 0 {
-				// TODO: Handle reconnection time.
-				c.logger.Debug("received retry event", slog.Int("retry", event.Retry))
-			}
-			if event.IsComment() {
-				c.logger.Debug("received comment", slog.String("comment", event.Comment))
-				continue
-			}
-			if err := handler(ctx, event); err != nil {
-				c.logger.Error("handling event", slog.Any("error", err))
-				return err
-			}
-		}
-	}
-}
-
-// Subscribe to an SSE event data stream.
-// This is a non-blocking call.
-//
-// NOTE: The event reader should not be shared between multiple listeners, otherwise, there might be unexpected parsing results.
-func (c *Client) Subscribe(ctx context.Context, path string, params any, handler EventStreamHandler, opts ...model.RequestOption) error {
-	cancellableContext, cancel := context.WithCancel(ctx)
-
-	r, err := c.listenToSSE(cancellableContext, path, params, opts...)
-	if err != nil {
-		cancel()
-		return fmt.Errorf("initializing SSE stream: %w", err)
-	}
-	defer func() {
-		if err := r.Close(); err != nil {
-			c.logger.Error("closing stream", slog.Any("error", err))
-		}
-		cancel()
-	}()
-
-	evtChannel, errChannel := make(chan *sse.Event, 1), make(chan error, 1)
-	go c.startReadingSSE(cancellableContext, r, evtChannel, errChannel)
-	go func() {
-	L:
-		for {
-			select {
-			case <-ctx.Done():
-				c.logger.Error("context cancelled", slog.Any("error", ctx.Err()))
-				break L
-			case err := <-errChannel:
-				if errors.Is(err, io.EOF) {
-					continue
-				}
-				c.logger.Error("reading from stream", slog.Any("error", err))
-				break L
-			case event := <-evtChannel:
-				if event.Retry != 0 {
-					// TODO: Handle reconnection time.
-					c.logger.Debug("received retry event", slog.Int("retry", event.Retry))
-				}
-				if event.IsComment() {
-					c.logger.Debug("received comment", slog.String("comment", event.Comment))
-					continue
-				}
-				if err := handler(ctx, event); err != nil {
-					c.logger.Error("handling event", slog.Any("error", err))
-					break L
-				}
-			}
-		}
-	}()
-
-	return nil
-}
-
-func (c *Client) listenToSSE(ctx context.Context, path string, params any, opts ...model.RequestOption) (io.ReadCloser, error) {
-	uri, err := c.encoder.EncodeParams(path, params)
-	if err != nil {
-		return nil, err
-	}
-	options := mergeOptions(opts...)
-
-	req := c.HTTP.R().SetContext(ctx)
-	req.SetQueryParamsFromValues(options.QueryParams)
-	req.SetHeaderMultiValues(options.Headers)
-	req.SetError(&alpacaerrors.ResponseError{})
-	req.SetHeader("Accept", "text/event-stream")
-	req.SetHeader("Connection", "keep-alive")
-	req.SetHeader("Cache-Control", "no-cache")
-	// Not parsing the response enables us to read the raw response body without it
-	// getting closed. Hence, allowing the SSE client to keep reading from the stream.
-	req.SetDoNotParseResponse(true)
-
-	res, err := c.executeRequest(ctx, req, http.MethodGet, uri, options.Trace)
-	if err != nil {
-		return nil, fmt.Errorf("executing request: %w", err)
-	}
-	return res.RawBody(), nil
-}
-
-func (c *Client) startReadingSSE(ctx context.Context, r io.ReadCloser, evtCh chan<- *sse.Event, errCh chan<- error) {
-	parser := sse.NewParser()
-	reader := bufio.NewReader(r)
-
-	for {
-		select {
-		case <-ctx.Done():
-			c.logger.Debug("context cancelled", slog.Any("error", ctx.Err()))
-			return
-		default:
-			if reader.Size() == 0 {
-				//nolint:mnd
-				time.Sleep(10 * time.Millisecond)
-			}
-			l, err := reader.ReadString('\n')
-			if err != nil {
-				if errors.Is(err, io.EOF) {
-					continue // Keep reading.
-				}
-				errCh <- fmt.Errorf("reading message: %w", err)
-				return
-			}
-			evt, err := parser.ParseEvent([]byte(l))
-			if err != nil {
-				errCh <- fmt.Errorf("parsing event: %w", err)
-				return
-			}
-			if evt.IsEmpty() {
-				continue
-			}
-			evtCh <- evt
-		}
-	}
-}
-
 func mergeOptions(opts ...model.RequestOption) *model.RequestOptions {
 	options := &model.RequestOptions{}
 	for _, o := range opts {
@@ -327,6 +196,17 @@ func mergeOptions(opts ...model.RequestOption) *model.RequestOptions {
 	return options
 }
 
+// discardBody drains and closes the body of an unparsed response, which is left open, to reuse the connection.
+func discardBody(res *resty.Response) {
+	if res == nil {
+		return
+	}
+	if body := res.RawBody(); body != nil {
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}
+}
+
 func parseResponseError(res *resty.Response) *alpacaerrors.ResponseError {
 	responseError, ok := alpacaerrors.AsResponseError(res.Error())
 	if !ok || responseError == nil {
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+
+	alpacaerrors "go.tradeforge.dev/alpaca/errors"
+	"go.tradeforge.dev/alpaca/model"
+)
+
+// Download makes a GET request and returns the raw response body, following redirects.
+// The request is bound to the context only, so that large files are not cut off by the client timeout.
+// The caller must close the returned body.
+func (c *Client) Download(ctx context.Context, path string, params any, opts ...model.RequestOption) (io.ReadCloser, error) {
+	uri, err := c.encoder.EncodeParams(path, params)
+	if err != nil {
+		return nil, err
+	}
+	req := &Request{
+		Method:  http.MethodGet,
+		URI:     uri,
+		Options: mergeOptions(opts...),
+		Stream:  true,
+	}
+	res, err := c.roundTrip(ctx, req, c.download)
+	if err != nil {
+		discardBody(res)
+		return nil, fmt.Errorf("executing request: %w", err)
+	}
+	return res.RawBody(), nil
+}
+
+func (c *Client) download(ctx context.Context, r *Request) (*resty.Response, error) {
+	req := c.HTTP.R()
+	req.SetQueryParamsFromValues(r.Options.QueryParams)
+	req.SetHeaderMultiValues(r.Options.Headers)
+	req.SetError(&alpacaerrors.ResponseError{})
+	req.SetDoNotParseResponse(true)
+
+	return c.executeWithRetry(ctx, req, r.Method, r.URI, r.Options, 0)
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+
+	"github.com/go-resty/resty/v2"
+
+	"go.tradeforge.dev/alpaca/model"
+)
+
+// Request describes an outgoing API call as seen by middlewares.
+type Request struct {
+	// Method is the HTTP method of the call.
+	Method string
+	// URI is the request URI with the path and query params already encoded.
+	URI string
+	// Options are the decoded request options. Middlewares may modify them before calling the next round trip.
+	Options *model.RequestOptions
+	// Stream is true for SSE calls and downloads. The body of a successful stream response must not be read by middlewares.
+	Stream bool
+}
+
+// RoundTrip executes a request and returns its response.
+// A failed call returns an *errors.ResponseError when the server responded with an error status code.
+type RoundTrip func(ctx context.Context, req *Request) (*resty.Response, error)
+
+// Middleware wraps a round trip with cross-cutting behaviour such as auditing, tagging, metrics or fault injection.
+type Middleware func(next RoundTrip) RoundTrip
+
+// Use appends middlewares to the chain wrapping every Call, CallURL and SSE stream request.
+// Middlewares are run in the order they were added, the first one being the outermost.
+//
+// NOTE: Use is not safe for concurrent use with in-flight calls and should be called while setting up the client.
+func (c *Client) Use(middlewares ...Middleware) *Client {
+	c.middlewares = append(c.middlewares, middlewares...)
+	return c
+}
+
+func (c *Client) roundTrip(ctx context.Context, req *Request, terminal RoundTrip) (*resty.Response, error) {
+	rt := terminal
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		rt = c.middlewares[i](rt)
+	}
+	return rt(ctx, req)
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"iter"
+
+	"go.tradeforge.dev/alpaca/model"
+)
+
+// Page is a single page of a paginated listing.
+type Page[T, C any] struct {
+	// Items are the entries of the page.
+	Items []T
+	// Next is the cursor of the following page. It is only meaningful if HasNext is true.
+	Next C
+	// HasNext reports whether there is another page to fetch.
+	HasNext bool
+}
+
+// PageFunc fetches the page identified by the cursor.
+type PageFunc[T, C any] func(ctx context.Context, cursor C) (Page[T, C], error)
+
+// Paginate returns an iterator over the items of a paginated listing, starting with the page identified by cursor.
+// Pages are fetched lazily as the iterator is consumed. If model.WithPrefetch is set, the next page is fetched
+// in the background while the items of the current page are yielded.
+//
+// The iteration stops at the first error, which is yielded together with the zero value of T,
+// or when the context is canceled.
+func Paginate[T, C any](ctx context.Context, cursor C, fetch PageFunc[T, C], opts ...model.RequestOption) iter.Seq2[T, error] {
+	prefetch := mergeOptions(opts...).Prefetch
+
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var zero T
+		next := fetchPage(ctx, fetch, cursor, false)
+		for {
+			page, err := next()
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if page.HasNext {
+				next = fetchPage(ctx, fetch, page.Next, prefetch)
+			}
+			for _, item := range page.Items {
+				if err := ctx.Err(); err != nil {
+					yield(zero, err)
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if !page.HasNext {
+				return
+			}
+		}
+	}
+}
+
+// fetchPage returns a function returning the page identified by the cursor.
+// If async is true, the page is fetched right away in the background.
+func fetchPage[T, C any](ctx context.Context, fetch PageFunc[T, C], cursor C, async bool) func() (Page[T, C], error) {
+	if !async {
+		return func() (Page[T, C], error) {
+			return fetch(ctx, cursor)
+		}
+	}
+
+	type result struct {
+		page Page[T, C]
+		err  error
+	}
+	// The channel is buffered so that the goroutine never blocks if the iteration stops early.
+	ch := make(chan result, 1)
+	go func() {
+		page, err := fetch(ctx, cursor)
+		ch <- result{page: page, err: err}
+	}()
+	return func() (Page[T, C], error) {
+		r := <-ch
+		return r.page, r.err
+	}
+}
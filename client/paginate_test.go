@@ -0,0 +1,205 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"go.tradeforge.dev/alpaca/model"
+)
+
+// fakePages serves pages of ints identified by their index and records the fetched cursors.
+type fakePages struct {
+	pages [][]int
+	// err is returned instead of the page at errAt, if set.
+	err   error
+	errAt int
+
+	mu      sync.Mutex
+	fetched []int
+}
+
+func (f *fakePages) fetch(_ context.Context, cursor int) (Page[int, int], error) {
+	f.mu.Lock()
+	f.fetched = append(f.fetched, cursor)
+	f.mu.Unlock()
+	if f.err != nil && cursor == f.errAt {
+		return Page[int, int]{}, f.err
+	}
+	return Page[int, int]{
+		Items:   f.pages[cursor],
+		Next:    cursor + 1,
+		HasNext: cursor+1 < len(f.pages),
+	}, nil
+}
+
+func (f *fakePages) cursors() []int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.fetched)
+}
+
+func TestPaginate(t *testing.T) {
+	errPage := errors.New("page error")
+	tests := []struct {
+		name        string
+		pages       *fakePages
+		prefetch    bool
+		want        []int
+		wantErr     error
+		wantFetched []int
+	}{
+		{
+			name:        "single page",
+			pages:       &fakePages{pages: [][]int{{1, 2}}},
+			want:        []int{1, 2},
+			wantFetched: []int{0},
+		},
+		{
+			name:        "several pages",
+			pages:       &fakePages{pages: [][]int{{1, 2}, {3}, {4, 5}}},
+			want:        []int{1, 2, 3, 4, 5},
+			wantFetched: []int{0, 1, 2},
+		},
+		{
+			name:        "several pages with prefetch",
+			pages:       &fakePages{pages: [][]int{{1, 2}, {3}, {4, 5}}},
+			prefetch:    true,
+			want:        []int{1, 2, 3, 4, 5},
+			wantFetched: []int{0, 1, 2},
+		},
+		{
+			name:        "empty page in between",
+			pages:       &fakePages{pages: [][]int{{1}, {}, {2}}},
+			prefetch:    true,
+			want:        []int{1, 2},
+			wantFetched: []int{0, 1, 2},
+		},
+		{
+			name:        "error on the first page",
+			pages:       &fakePages{pages: [][]int{{1}, {2}}, err: errPage, errAt: 0},
+			wantErr:     errPage,
+			wantFetched: []int{0},
+		},
+		{
+			name:        "error on a later page",
+			pages:       &fakePages{pages: [][]int{{1, 2}, {3}, {4}}, err: errPage, errAt: 1},
+			want:        []int{1, 2},
+			wantErr:     errPage,
+			wantFetched: []int{0, 1},
+		},
+		{
+			name:        "error on a prefetched page",
+			pages:       &fakePages{pages: [][]int{{1, 2}, {3}, {4}}, err: errPage, errAt: 1},
+			prefetch:    true,
+			want:        []int{1, 2},
+			wantErr:     errPage,
+			wantFetched: []int{0, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			var gotErr error
+			for item, err := range Paginate(context.Background(), 0, tt.pages.fetch, model.WithPrefetch(tt.prefetch)) {
+				if err != nil {
+					if item != 0 {
+						t.Errorf("got item %d with the error, want the zero value", item)
+					}
+					if gotErr != nil {
+						t.Fatalf("got a second error %v after %v", err, gotErr)
+					}
+					gotErr = err
+					continue
+				}
+				got = append(got, item)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got items %v, want %v", got, tt.want)
+			}
+			if !errors.Is(gotErr, tt.wantErr) {
+				t.Errorf("got error %v, want %v", gotErr, tt.wantErr)
+			}
+			if fetched := tt.pages.cursors(); !slices.Equal(fetched, tt.wantFetched) {
+				t.Errorf("fetched pages %v, want %v", fetched, tt.wantFetched)
+			}
+		})
+	}
+}
+
+func TestPaginatePrefetch(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefetch bool
+		// wantFetched are the pages fetched while the first item is handled.
+		wantFetched []int
+	}{
+		{name: "lazy", prefetch: false, wantFetched: []int{0}},
+		{name: "prefetch", prefetch: true, wantFetched: []int{0, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pages := &fakePages{pages: [][]int{{1, 2}, {3}}}
+			for range Paginate(context.Background(), 0, pages.fetch, model.WithPrefetch(tt.prefetch)) {
+				if tt.prefetch {
+					eventually(t, func() bool { return len(pages.cursors()) == 2 }, "next page not prefetched")
+				}
+				if fetched := pages.cursors(); !slices.Equal(fetched, tt.wantFetched) {
+					t.Errorf("fetched pages %v, want %v", fetched, tt.wantFetched)
+				}
+				break
+			}
+		})
+	}
+}
+
+func TestPaginateStopsPrefetchOnBreak(t *testing.T) {
+	returned := make(chan error, 1)
+	fetch := func(ctx context.Context, cursor int) (Page[int, int], error) {
+		if cursor == 0 {
+			return Page[int, int]{Items: []int{1, 2}, Next: 1, HasNext: true}, nil
+		}
+		// The prefetched page only completes once the iteration is canceled.
+		<-ctx.Done()
+		returned <- ctx.Err()
+		return Page[int, int]{}, ctx.Err()
+	}
+
+	for range Paginate(context.Background(), 0, fetch, model.WithPrefetch(true)) {
+		break
+	}
+	select {
+	case err := <-returned:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got error %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("prefetch goroutine still running after the iteration stopped")
+	}
+}
+
+func TestPaginateContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pages := &fakePages{pages: [][]int{{1, 2, 3}, {4}}}
+
+	var got []int
+	var gotErr error
+	for item, err := range Paginate(ctx, 0, pages.fetch) {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, item)
+		cancel()
+	}
+	if want := []int{1}; !slices.Equal(got, want) {
+		t.Errorf("got items %v, want %v", got, want)
+	}
+	if !errors.Is(gotErr, context.Canceled) {
+		t.Errorf("got error %v, want %v", gotErr, context.Canceled)
+	}
+}
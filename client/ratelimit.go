@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+
+	DefaultRateLimitWindow = time.Minute
+)
+
+// RateLimitBudget is a snapshot of the rate limiter state.
+type RateLimitBudget struct {
+	// Limit is the number of requests allowed per window. Zero means the limit is not known yet.
+	Limit int
+	// Remaining is the number of requests that can be made right away.
+	Remaining int
+	// Reset is the time at which the budget is fully restored, as reported by the server.
+	Reset time.Time
+}
+
+// RateLimiter is a token-bucket rate limiter that follows the X-RateLimit-* response headers.
+//
+// Until the server reports a limit, the limiter lets every request through.
+// Once known, the bucket holds Limit tokens and refills evenly over the window.
+// The server-reported remaining budget and reset time take precedence over the local estimate:
+// while a reset is pending, the bucket is only refilled when the reset time is reached.
+type RateLimiter struct {
+	mu sync.Mutex
+
+	window    time.Duration
+	limit     int
+	tokens    float64
+	reset     time.Time
+	updatedAt time.Time
+}
+
+// NewRateLimiter returns a rate limiter that allows limit requests per window.
+// A limit of zero defers to the first X-RateLimit-Limit header received.
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		window:    window,
+		limit:     limit,
+		tokens:    float64(limit),
+		updatedAt: time.Now(),
+	}
+}
+
+// Wait blocks until a request can be made or the context is canceled.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		delay := l.reserve(time.Now())
+		if delay == 0 {
+			return nil
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Update synchronizes the limiter with the rate limit headers of a response.
+func (l *RateLimiter) Update(header http.Header) {
+	limit, hasLimit := parseIntHeader(header, headerRateLimitLimit)
+	remaining, hasRemaining := parseIntHeader(header, headerRateLimitRemaining)
+	reset, hasReset := parseIntHeader(header, headerRateLimitReset)
+	if !hasLimit && !hasRemaining && !hasReset {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+	if hasLimit && limit > 0 {
+		l.limit = limit
+	}
+	if hasRemaining {
+		l.tokens = float64(max(remaining, 0))
+	}
+	if hasReset {
+		l.reset = time.Unix(int64(reset), 0)
+	}
+	l.updatedAt = now
+}
+
+// Budget returns the current state of the limiter.
+func (l *RateLimiter) Budget() RateLimitBudget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(time.Now())
+	return RateLimitBudget{
+		Limit:     l.limit,
+		Remaining: int(l.tokens),
+		Reset:     l.reset,
+	}
+}
+
+// reserve takes a token if one is available and returns zero.
+// Otherwise, it returns how long to wait before trying again.
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.limit <= 0 {
+		return 0
+	}
+	l.refill(now)
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	if !l.reset.IsZero() && l.reset.After(now) {
+		return l.reset.Sub(now)
+	}
+	perToken := l.window / time.Duration(l.limit)
+	return max(time.Duration((1-l.tokens)*float64(perToken)), time.Millisecond)
+}
+
+func (l *RateLimiter) refill(now time.Time) {
+	if l.limit <= 0 {
+		return
+	}
+	if !l.reset.IsZero() {
+		// The server window is authoritative: the budget is restored all at once when it resets.
+		if !now.Before(l.reset) {
+			l.tokens = float64(l.limit)
+			l.reset = time.Time{}
+		}
+		l.updatedAt = now
+		return
+	}
+	elapsed := now.Sub(l.updatedAt)
+	if elapsed <= 0 {
+		return
+	}
+	l.tokens = min(l.tokens+elapsed.Seconds()*float64(l.limit)/l.window.Seconds(), float64(l.limit))
+	l.updatedAt = now
+}
+
+func parseIntHeader(header http.Header, key string) (int, bool) {
+	v := header.Get(key)
+	if v == "" {
+		return 0, false
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, false
+	}
+	return i, true
+}
+
+// SetRateLimiter replaces the rate limiter shared by all calls made through the client.
+// A nil limiter disables client-side rate limiting.
+func (c *Client) SetRateLimiter(limiter *RateLimiter) *Client {
+	c.rateLimiter = limiter
+	return c
+}
+
+// RateLimitBudget returns the remaining request budget as tracked by the client-side rate limiter.
+func (c *Client) RateLimitBudget() RateLimitBudget {
+	if c.rateLimiter == nil {
+		return RateLimitBudget{}
+	}
+	return c.rateLimiter.Budget()
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func rateLimitHeader(limit, remaining int, reset time.Time) http.Header {
+	header := http.Header{}
+	header.Set(headerRateLimitLimit, strconv.Itoa(limit))
+	header.Set(headerRateLimitRemaining, strconv.Itoa(remaining))
+	if !reset.IsZero() {
+		header.Set(headerRateLimitReset, strconv.FormatInt(reset.Unix(), 10))
+	}
+	return header
+}
+
+func TestRateLimiterUnknownLimit(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+	}{
+		{name: "no header"},
+		{name: "zero limit header", header: rateLimitHeader(0, 0, time.Time{})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewRateLimiter(0, time.Minute)
+			if tt.header != nil {
+				l.Update(tt.header)
+			}
+			now := time.Now()
+			for i := range 1000 {
+				if delay := l.reserve(now); delay != 0 {
+					t.Fatalf("request %d: got delay %v, want none", i, delay)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimiterLocalRefill(t *testing.T) {
+	l := NewRateLimiter(2, 2*time.Second)
+	start := l.updatedAt
+
+	steps := []struct {
+		at   time.Duration
+		want time.Duration
+	}{
+		{at: 0, want: 0},
+		{at: 0, want: 0},
+		{at: 0, want: time.Second},
+		{at: 500 * time.Millisecond, want: 500 * time.Millisecond},
+		{at: time.Second, want: 0},
+		{at: time.Second, want: time.Second},
+		// The bucket never holds more than the limit, however long it was idle.
+		{at: time.Hour, want: 0},
+		{at: time.Hour, want: 0},
+		{at: time.Hour, want: time.Second},
+	}
+	for i, step := range steps {
+		if got := l.reserve(start.Add(step.at)); got != step.want {
+			t.Errorf("step %d at %v: got delay %v, want %v", i, step.at, got, step.want)
+		}
+	}
+}
+
+func TestRateLimiterServerReset(t *testing.T) {
+	l := NewRateLimiter(0, time.Minute)
+	reset := time.Unix(time.Now().Add(30*time.Second).Unix(), 0)
+	l.Update(rateLimitHeader(10, 0, reset))
+
+	// The local refill would allow a request after 6 seconds, but the server reset takes precedence.
+	at := reset.Add(-20 * time.Second)
+	if got, want := l.reserve(at), 20*time.Second; got != want {
+		t.Errorf("before reset: got delay %v, want %v", got, want)
+	}
+	if got := l.reserve(reset); got != 0 {
+		t.Errorf("at reset: got delay %v, want none", got)
+	}
+	if got, want := l.Budget(), (RateLimitBudget{Limit: 10, Remaining: 9}); got != want {
+		t.Errorf("got budget %+v, want %+v", got, want)
+	}
+}
+
+func TestRateLimiterServerRemaining(t *testing.T) {
+	l := NewRateLimiter(100, time.Minute)
+	l.Update(rateLimitHeader(100, 1, time.Now().Add(time.Minute)))
+
+	now := time.Now()
+	if got := l.reserve(now); got != 0 {
+		t.Errorf("got delay %v, want none", got)
+	}
+	if got := l.reserve(now); got <= 0 {
+		t.Errorf("got no delay once the server budget is used up")
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	l := NewRateLimiter(1, time.Hour)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := l.Wait(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Wait returned after %v, want as soon as the context is done", elapsed)
+	}
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.tradeforge.dev/alpaca/model"
+	"go.tradeforge.dev/alpaca/sse"
+)
+
+const (
+	DefaultReconnectMaxAttempts = 10
+	DefaultReconnectBaseDelay   = time.Second
+	DefaultReconnectMaxDelay    = 30 * time.Second
+	DefaultReconnectJitter      = 0.2
+)
+
+// ReconnectPolicy configures how dropped SSE streams are reconnected.
+type ReconnectPolicy struct {
+	// MaxAttempts is the number of consecutive reconnection attempts before giving up.
+	// Zero disables reconnecting and a negative value retries forever.
+	MaxAttempts int
+	// BaseDelay is the delay before the first attempt. It is overridden by the retry value sent by the server.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts.
+	MaxDelay time.Duration
+	// Jitter is the fraction (0 to 1) of the delay that is randomized.
+	Jitter float64
+}
+
+// DefaultReconnectPolicy returns the reconnect policy used by new clients.
+func DefaultReconnectPolicy() ReconnectPolicy {
+	return ReconnectPolicy{
+		MaxAttempts: DefaultReconnectMaxAttempts,
+		BaseDelay:   DefaultReconnectBaseDelay,
+		MaxDelay:    DefaultReconnectMaxDelay,
+		Jitter:      DefaultReconnectJitter,
+	}
+}
+
+// SetReconnectPolicy sets the policy used to reconnect dropped SSE streams.
+func (c *Client) SetReconnectPolicy(policy ReconnectPolicy) *Client {
+	c.reconnectPolicy = policy
+	return c
+}
+
+func (p ReconnectPolicy) delay(attempt int, serverRetry time.Duration) time.Duration {
+	base := p.BaseDelay
+	if serverRetry > 0 {
+		base = serverRetry
+	}
+	return backoff(base, max(p.MaxDelay, base), p.Jitter, attempt)
+}
+
+// eventIdentity identifies an event within a stream. Alpaca event payloads carry
+// a sequential event_id and, for most streams, a lexicographically sortable event_ulid.
+type eventIdentity struct {
+	ID   string
+	ULID string
+}
+
+func parseEventIdentity(event *sse.Event) eventIdentity {
+	payload := struct {
+		ID   json.RawMessage `json:"event_id"`
+		ULID string          `json:"event_ulid"`
+	}{}
+	if err := json.Unmarshal(event.Data, &payload); err != nil {
+		return eventIdentity{}
+	}
+	return eventIdentity{
+		ID:   strings.Trim(string(payload.ID), `"`),
+		ULID: payload.ULID,
+	}
+}
+
+// checkpoint tracks the last event delivered to a stream handler.
+type checkpoint struct {
+	last eventIdentity
+	// lastEventID is the SSE id of the last delivered event, sent back in the Last-Event-ID header.
+	lastEventID string
+	// replaying is set after a reconnect until the first event newer than the checkpoint. Only the events
+	// replayed in this window are checked for duplicates, as event IDs are not guaranteed to be monotonic.
+	replaying bool
+}
+
+// isDuplicate reports whether the event was already delivered, i.e. it is not newer than the checkpoint.
+func (c *checkpoint) isDuplicate(id eventIdentity) bool {
+	if id.ULID != "" && c.last.ULID != "" {
+		return id.ULID <= c.last.ULID
+	}
+	if id.ID != "" && c.last.ID != "" {
+		current, errCurrent := strconv.ParseInt(id.ID, 10, 64)
+		last, errLast := strconv.ParseInt(c.last.ID, 10, 64)
+		if errCurrent == nil && errLast == nil {
+			return current <= last
+		}
+		return id.ID == c.last.ID
+	}
+	return false
+}
+
+func (c *checkpoint) advance(id eventIdentity, lastEventID string) {
+	if lastEventID != "" {
+		c.lastEventID = lastEventID
+	}
+	if id.ID != "" {
+		c.last.ID = id.ID
+	}
+	if id.ULID != "" {
+		c.last.ULID = id.ULID
+	}
+}
+
+// resume rewrites the stream params so that the stream starts after the checkpoint.
+func (c *checkpoint) resume(params any) any {
+	if c.last == (eventIdentity{}) {
+		return params
+	}
+	if p, ok := params.(model.ResumableParams); ok {
+		return p.Resume(c.last.ID, c.last.ULID)
+	}
+	return params
+}
@@ -0,0 +1,239 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+
+	alpacaerrors "go.tradeforge.dev/alpaca/errors"
+	"go.tradeforge.dev/alpaca/model"
+)
+
+const (
+	DefaultRetryMaxAttempts = 3
+	DefaultRetryBaseDelay   = 250 * time.Millisecond
+	DefaultRetryMaxDelay    = 10 * time.Second
+	DefaultRetryJitter      = 0.5
+)
+
+// RetryPolicy configures how failed requests are retried.
+//
+// Requests using an idempotent HTTP method (GET, HEAD, OPTIONS, PUT and DELETE) are retried by default.
+// Other requests (e.g. POST) are only retried when they carry a client-supplied idempotency key,
+// either through model.IdempotencyKey or a body implementing model.IdempotentRequest.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one. A value lower than 2 disables retries.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. It is doubled on every subsequent retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts, including the one requested by the Retry-After header.
+	MaxDelay time.Duration
+	// Jitter is the fraction (0 to 1) of the delay that is randomized to spread out retries of concurrent callers.
+	Jitter float64
+	// RetryableStatusCodes are the response status codes that trigger a retry.
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryPolicy returns the retry policy used by new clients.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: DefaultRetryMaxAttempts,
+		BaseDelay:   DefaultRetryBaseDelay,
+		MaxDelay:    DefaultRetryMaxDelay,
+		Jitter:      DefaultRetryJitter,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// NoRetryPolicy returns a retry policy that makes exactly one attempt.
+func NoRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 1}
+}
+
+// SetRetryPolicy sets the policy used to retry failed requests.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) *Client {
+	c.retryPolicy = policy
+	return c
+}
+
+// isRetryable reports whether a request can be safely repeated.
+func (p RetryPolicy) isRetryable(method string, options *model.RequestOptions) bool {
+	if p.MaxAttempts < 2 || options.NoRetry {
+		return false
+	}
+	if _, ok := options.Body.(io.Reader); ok {
+		// A streamed body is consumed by the first attempt.
+		return false
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	if options.IdempotencyKey != "" {
+		return true
+	}
+	if r, ok := options.Body.(model.IdempotentRequest); ok {
+		return r.IdempotencyKey() != ""
+	}
+	return false
+}
+
+// shouldRetry reports whether the failed attempt should be retried.
+func (p RetryPolicy) shouldRetry(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	responseError, ok := alpacaerrors.AsResponseError(err)
+	if !ok {
+		// Transport errors (connection reset, timeout, ...) are always worth another attempt.
+		return !errors.Is(err, context.Canceled)
+	}
+	return slices.Contains(p.RetryableStatusCodes, responseError.StatusCode)
+}
+
+// delay returns how long to wait before the next attempt.
+// The Retry-After header takes precedence over the exponential backoff.
+func (p RetryPolicy) delay(attempt int, res *resty.Response) time.Duration {
+	if d, ok := parseRetryAfter(res); ok {
+		return min(d, p.MaxDelay)
+	}
+	return backoff(p.BaseDelay, p.MaxDelay, p.Jitter, attempt)
+}
+
+// backoff returns an exponentially growing delay for the given attempt, capped by maxDelay.
+// The jitter fraction of the delay is randomized.
+func backoff(base, maxDelay time.Duration, jitter float64, attempt int) time.Duration {
+	d := base << (attempt - 1)
+	if d <= 0 || d > maxDelay {
+		d = maxDelay
+	}
+	if jitter > 0 {
+		//nolint:gosec
+		d -= time.Duration(rand.Float64() * min(jitter, 1) * float64(d))
+	}
+	return d
+}
+
+func parseRetryAfter(res *resty.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+	v := res.Header().Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
+func (c *Client) executeWithRetry(
+	ctx context.Context,
+	req *resty.Request,
+	method string,
+	uri string,
+	options *model.RequestOptions,
+	timeout time.Duration,
+) (*resty.Response, error) {
+	retryable := c.retryPolicy.isRetryable(method, options)
+	for attempt := 1; ; attempt++ {
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(ctx); err != nil {
+				return nil, fmt.Errorf("waiting for rate limiter: %w", err)
+			}
+		}
+		res, err := c.executeAttempt(ctx, req, method, uri, options.Trace, timeout)
+		if res != nil && c.rateLimiter != nil {
+			c.rateLimiter.Update(res.Header())
+		}
+		if err == nil {
+			return res, nil
+		}
+		if !retryable || attempt >= c.retryPolicy.MaxAttempts || !c.retryPolicy.shouldRetry(ctx, err) {
+			return res, err
+		}
+
+		delay := c.retryPolicy.delay(attempt, res)
+		var requestID string
+		if res != nil {
+			requestID = res.Header().Get("X-Request-ID")
+			// The body of a failed attempt is not handed to the caller, so it must be released here.
+			if body := res.RawBody(); body != nil {
+				_ = body.Close()
+			}
+		}
+		c.logger.Warn(
+			"retrying request",
+			slog.String("url", uri),
+			slog.String("method", method),
+			slog.Int("attempt", attempt),
+			slog.String("request id", requestID),
+			slog.Duration("delay", delay),
+			slog.Any("error", err),
+		)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return res, err
+		case <-timer.C:
+		}
+	}
+}
+
+// executeAttempt executes a single attempt of the request, bounded by the timeout if it is positive.
+func (c *Client) executeAttempt(
+	ctx context.Context,
+	req *resty.Request,
+	method string,
+	uri string,
+	trace bool,
+	timeout time.Duration,
+) (*resty.Response, error) {
+	if timeout <= 0 {
+		req.SetContext(ctx)
+		return c.executeRequest(ctx, req, method, uri, trace)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	req.SetContext(ctx)
+	res, err := c.executeRequest(ctx, req, method, uri, trace)
+	if res == nil || res.RawResponse == nil || res.Body() != nil {
+		// resty reads and closes the body of parsed responses, so Body is only nil for unparsed ones.
+		cancel()
+		return res, err
+	}
+	// The body of an unparsed response is read after the attempt, so the timeout is only released once it is closed.
+	res.RawResponse.Body = &cancelOnClose{ReadCloser: res.RawResponse.Body, cancel: cancel}
+	return res, err
+}
+
+// cancelOnClose cancels the context of a request once its response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
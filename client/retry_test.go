@@ -0,0 +1,383 @@
+package client
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+
+	alpacaerrors "go.tradeforge.dev/alpaca/errors"
+	"go.tradeforge.dev/alpaca/model"
+)
+
+// newTestClient returns a client for the test server that retries quickly and without jitter.
+func newTestClient(t *testing.T, url string) *Client {
+	t.Helper()
+	c := New(url, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	c.SetRetryPolicy(RetryPolicy{
+		MaxAttempts:          3,
+		BaseDelay:            time.Millisecond,
+		MaxDelay:             10 * time.Millisecond,
+		RetryableStatusCodes: DefaultRetryPolicy().RetryableStatusCodes,
+	})
+	return c
+}
+
+// statusServer responds with the given status codes in turn, repeating the last one, and counts the requests.
+func statusServer(t *testing.T, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(requests.Add(1))
+		status := statuses[min(n, len(statuses))-1]
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, `{"message":"`+http.StatusText(status)+`"}`)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+type idempotentBody struct {
+	Key string `json:"key"`
+}
+
+func (b idempotentBody) IdempotencyKey() string {
+	return b.Key
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		attempt int
+		want    time.Duration
+	}{
+		{name: "first attempt", attempt: 1, want: 100 * time.Millisecond},
+		{name: "doubled", attempt: 2, want: 200 * time.Millisecond},
+		{name: "doubled twice", attempt: 3, want: 400 * time.Millisecond},
+		{name: "capped", attempt: 5, want: time.Second},
+		{name: "overflow is capped", attempt: 80, want: time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := backoff(100*time.Millisecond, time.Second, 0, tt.attempt); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackoffJitter(t *testing.T) {
+	for range 100 {
+		got := backoff(100*time.Millisecond, time.Second, 0.5, 2)
+		if got < 100*time.Millisecond || got > 200*time.Millisecond {
+			t.Fatalf("got %v, want between 100ms and 200ms", got)
+		}
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: 5 * time.Second}
+	tests := []struct {
+		name       string
+		retryAfter string
+		want       time.Duration
+	}{
+		{name: "no header", want: 200 * time.Millisecond},
+		{name: "seconds", retryAfter: "2", want: 2 * time.Second},
+		{name: "seconds are capped", retryAfter: "60", want: 5 * time.Second},
+		{name: "past date", retryAfter: "Mon, 02 Jan 2006 15:04:05 GMT", want: 0},
+		{name: "invalid header", retryAfter: "soon", want: 200 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.retryAfter != "" {
+				header.Set("Retry-After", tt.retryAfter)
+			}
+			res := &resty.Response{RawResponse: &http.Response{Header: header}}
+			if got := policy.delay(2, res); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyIsRetryable(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	tests := []struct {
+		name    string
+		policy  RetryPolicy
+		method  string
+		options model.RequestOptions
+		want    bool
+	}{
+		{name: "GET", policy: policy, method: http.MethodGet, want: true},
+		{name: "DELETE", policy: policy, method: http.MethodDelete, want: true},
+		{name: "PUT", policy: policy, method: http.MethodPut, want: true},
+		{name: "POST", policy: policy, method: http.MethodPost, want: false},
+		{name: "PATCH", policy: policy, method: http.MethodPatch, want: false},
+		{
+			name:    "POST with idempotency key",
+			policy:  policy,
+			method:  http.MethodPost,
+			options: model.RequestOptions{IdempotencyKey: "key"},
+			want:    true,
+		},
+		{
+			name:    "POST with idempotent body",
+			policy:  policy,
+			method:  http.MethodPost,
+			options: model.RequestOptions{Body: idempotentBody{Key: "key"}},
+			want:    true,
+		},
+		{
+			name:    "POST with empty idempotency key in body",
+			policy:  policy,
+			method:  http.MethodPost,
+			options: model.RequestOptions{Body: idempotentBody{}},
+			want:    false,
+		},
+		{
+			name:    "NoRetry",
+			policy:  policy,
+			method:  http.MethodGet,
+			options: model.RequestOptions{NoRetry: true},
+			want:    false,
+		},
+		{
+			name:    "streamed body",
+			policy:  policy,
+			method:  http.MethodPut,
+			options: model.RequestOptions{Body: strings.NewReader("data")},
+			want:    false,
+		},
+		{name: "single attempt policy", policy: NoRetryPolicy(), method: http.MethodGet, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.isRetryable(tt.method, &tt.options); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCallRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		opts         []model.RequestOption
+		statuses     []int
+		wantAttempts int32
+		wantStatus   int
+	}{
+		{
+			name:         "GET is retried until it succeeds",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 2,
+		},
+		{
+			name:         "GET gives up after the max attempts",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusBadGateway},
+			wantAttempts: 3,
+			wantStatus:   http.StatusBadGateway,
+		},
+		{
+			name:         "client errors are not retried",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusUnprocessableEntity},
+			wantAttempts: 1,
+			wantStatus:   http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "POST without key is not retried",
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 1,
+			wantStatus:   http.StatusServiceUnavailable,
+		},
+		{
+			name:         "POST with key is retried",
+			method:       http.MethodPost,
+			opts:         []model.RequestOption{model.IdempotencyKey("key")},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 2,
+		},
+		{
+			name:         "POST with idempotent body is retried",
+			method:       http.MethodPost,
+			opts:         []model.RequestOption{model.Body(idempotentBody{Key: "key"})},
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			wantAttempts: 2,
+		},
+		{
+			name:         "NoRetry makes a single attempt",
+			method:       http.MethodGet,
+			opts:         []model.RequestOption{model.NoRetry()},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 1,
+			wantStatus:   http.StatusServiceUnavailable,
+		},
+		{
+			name:         "streamed body makes a single attempt",
+			method:       http.MethodPut,
+			opts:         []model.RequestOption{model.Body(strings.NewReader("data"))},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 1,
+			wantStatus:   http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, requests := statusServer(t, tt.statuses...)
+			c := newTestClient(t, srv.URL)
+
+			var res map[string]any
+			err := c.CallURL(context.Background(), tt.method, "/", &res, tt.opts...)
+			if got := requests.Load(); got != tt.wantAttempts {
+				t.Errorf("got %d attempts, want %d", got, tt.wantAttempts)
+			}
+			if tt.wantStatus == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			responseError, ok := alpacaerrors.AsResponseError(err)
+			if !ok {
+				t.Fatalf("got error %v, want a response error", err)
+			}
+			if responseError.StatusCode != tt.wantStatus {
+				t.Errorf("got status %d, want %d", responseError.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestCallRetriesHonorContext(t *testing.T) {
+	srv, requests := statusServer(t, http.StatusServiceUnavailable)
+	c := newTestClient(t, srv.URL)
+	c.SetRetryPolicy(RetryPolicy{
+		MaxAttempts:          10,
+		BaseDelay:            time.Hour,
+		MaxDelay:             time.Hour,
+		RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := c.CallURL(ctx, http.MethodGet, "/", &map[string]any{}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("got %d attempts, want 1", got)
+	}
+}
+
+// closeTracker counts the response bodies that are opened and closed.
+type closeTracker struct {
+	next   http.RoundTripper
+	mu     sync.Mutex
+	opened int
+	closed int
+}
+
+func (c *closeTracker) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := c.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.opened++
+	c.mu.Unlock()
+	res.Body = &trackedBody{ReadCloser: res.Body, tracker: c}
+	return res, nil
+}
+
+func (c *closeTracker) counts() (opened, closed int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.opened, c.closed
+}
+
+type trackedBody struct {
+	io.ReadCloser
+	tracker *closeTracker
+	once    sync.Once
+}
+
+func (b *trackedBody) Close() error {
+	b.once.Do(func() {
+		b.tracker.mu.Lock()
+		b.tracker.closed++
+		b.tracker.mu.Unlock()
+	})
+	return b.ReadCloser.Close()
+}
+
+func TestFailedAttemptBodiesAreClosed(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []int
+		wantErr  bool
+	}{
+		{name: "retried until success", statuses: []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusNoContent}},
+		{name: "retries exhausted", statuses: []int{http.StatusServiceUnavailable}, wantErr: true},
+		{name: "not retryable", statuses: []int{http.StatusNotFound}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, _ := statusServer(t, tt.statuses...)
+			c := newTestClient(t, srv.URL)
+			tracker := &closeTracker{next: http.DefaultTransport}
+			c.HTTP.SetTransport(tracker)
+
+			// Without a response, the body is not parsed by resty and must be closed by the client.
+			err := c.CallURL(context.Background(), http.MethodDelete, "/", nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error: %v", err, tt.wantErr)
+			}
+			opened, closed := tracker.counts()
+			if opened == 0 || closed != opened {
+				t.Errorf("closed %d of %d bodies", closed, opened)
+			}
+		})
+	}
+}
+
+func TestUnparsedResponsesReuseConnection(t *testing.T) {
+	var conns atomic.Int32
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, strings.Repeat("x", 64<<10))
+	}))
+	srv.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	srv.Start()
+	t.Cleanup(srv.Close)
+	c := newTestClient(t, srv.URL)
+
+	// The body is drained after the attempt, so the attempt timeout must still be running.
+	for range 3 {
+		if err := c.CallURL(context.Background(), http.MethodDelete, "/", nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := conns.Load(); got != 1 {
+		t.Errorf("got %d connections, want 1", got)
+	}
+}
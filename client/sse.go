@@ -0,0 +1,351 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+
+	alpacaerrors "go.tradeforge.dev/alpaca/errors"
+	"go.tradeforge.dev/alpaca/model"
+	"go.tradeforge.dev/alpaca/sse"
+)
+
+type EventStreamHandler func(ctx context.Context, event *sse.Event) error
+
+// Listen to an event data stream.
+// This is a blocking call that will continue to read from the stream until the context is canceled
+// or the watch is stopped.
+//
+// The stream is opened and reconnected according to the client reconnect policy. If the params implement
+// model.ResumableParams, the stream resumes after the last event delivered to the handler and
+// events replayed across the reconnect boundary are not delivered twice.
+//
+// NOTE: The event reader should not be shared between multiple listeners, otherwise, there might be unexpected parsing results.
+func (c *Client) Listen(ctx context.Context, path string, params any, handler EventStreamHandler, opts ...model.RequestOption) error {
+	s, err := c.openEventStream(ctx, path, params, opts...)
+	if err != nil {
+		return fmt.Errorf("initializing SSE stream: %w", err)
+	}
+	return s.run(ctx, handler)
+}
+
+// Subscribe to an SSE event data stream.
+// This is a non-blocking call. The returned subscription is used to stop the stream and to learn why it ended.
+//
+// Only the first connection attempt is made before Subscribe returns. Its error is returned if it is not
+// worth retrying (e.g. invalid credentials); otherwise the stream is reconnected in the background the
+// same way as in Listen, and giving up is reported through the Err and Done methods of the subscription.
+//
+// NOTE: The event reader should not be shared between multiple listeners, otherwise, there might be unexpected parsing results.
+func (c *Client) Subscribe(ctx context.Context, path string, params any, handler EventStreamHandler, opts ...model.RequestOption) (*Subscription, error) {
+	cancellableContext, cancel := context.WithCancel(ctx)
+
+	s := c.newEventStream(path, params, opts...)
+	connectErr := s.connect(cancellableContext)
+	if connectErr != nil && !c.retryPolicy.shouldRetry(cancellableContext, connectErr) {
+		cancel()
+		return nil, fmt.Errorf("initializing SSE stream: %w", connectErr)
+	}
+	sub := newSubscription(s, cancel)
+	go func() {
+		defer cancel()
+		err := s.start(cancellableContext, connectErr, handler)
+		if err != nil {
+			c.logger.Error("stream stopped", slog.String("path", path), slog.Any("error", err))
+		}
+		sub.finish(err)
+	}()
+
+	return sub, nil
+}
+
+// eventStream is a single logical SSE stream that survives reconnects.
+type eventStream struct {
+	client *Client
+	path   string
+	params any
+	opts   []model.RequestOption
+
+	body       io.ReadCloser
+	checkpoint checkpoint
+	// retry is the reconnection time advertised by the server.
+	retry time.Duration
+	// failures counts the reconnection attempts since the last delivered event.
+	failures int
+	stats    streamStats
+}
+
+// handlerError marks an error returned by the event handler, which ends the stream without reconnecting.
+type handlerError struct {
+	err error
+}
+
+func (e *handlerError) Error() string {
+	return e.err.Error()
+}
+
+func (e *handlerError) Unwrap() error {
+	return e.err
+}
+
+func (c *Client) newEventStream(path string, params any, opts ...model.RequestOption) *eventStream {
+	return &eventStream{
+		client: c,
+		path:   path,
+		params: params,
+		opts:   opts,
+	}
+}
+
+func (c *Client) openEventStream(ctx context.Context, path string, params any, opts ...model.RequestOption) (*eventStream, error) {
+	s := c.newEventStream(path, params, opts...)
+	if err := s.connect(ctx); err != nil {
+		if !c.retryPolicy.shouldRetry(ctx, err) {
+			return nil, err
+		}
+		if err := s.reconnect(ctx, err); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *eventStream) connect(ctx context.Context) error {
+	// Failed attempts are retried by the reconnect policy only, so that the two policies do not multiply.
+	opts := append(slices.Clip(s.opts), model.NoRetry())
+	if s.checkpoint.lastEventID != "" {
+		opts = append(opts, model.Header("Last-Event-ID", s.checkpoint.lastEventID))
+	}
+	body, err := s.client.listenToSSE(ctx, s.path, s.checkpoint.resume(s.params), opts...)
+	if err != nil {
+		return err
+	}
+	s.body = body
+	return nil
+}
+
+func (s *eventStream) close() {
+	if s.body == nil {
+		return
+	}
+	if err := s.body.Close(); err != nil {
+		s.client.logger.Error("closing stream", slog.Any("error", err))
+	}
+	s.body = nil
+}
+
+// start reconnects after a failed first connection attempt, if any, and runs the stream.
+func (s *eventStream) start(ctx context.Context, connectErr error, handler EventStreamHandler) error {
+	if connectErr != nil {
+		if err := s.reconnect(ctx, connectErr); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("initializing SSE stream: %w", err)
+		}
+	}
+	return s.run(ctx, handler)
+}
+
+// run consumes the stream until the context is canceled, the handler fails or reconnecting gives up.
+func (s *eventStream) run(ctx context.Context, handler EventStreamHandler) error {
+	defer s.close()
+
+	for {
+		err := s.consume(ctx, handler)
+		if ctx.Err() != nil {
+			s.client.logger.Error("context cancelled", slog.Any("error", ctx.Err()))
+			return nil
+		}
+		var hErr *handlerError
+		if errors.As(err, &hErr) {
+			s.client.logger.Error("handling event", slog.Any("error", hErr.err))
+			return hErr.err
+		}
+		s.client.logger.Error("reading from stream", slog.Any("error", err))
+		if err := s.reconnect(ctx, err); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// consume reads events from the current connection until it fails.
+func (s *eventStream) consume(ctx context.Context, handler EventStreamHandler) error {
+	readCtx, cancel := context.WithCancel(ctx)
+	defer func() {
+		cancel()
+		s.close()
+	}()
+
+	evtChannel, errChannel := make(chan *sse.Event, 1), make(chan error, 1)
+	go s.client.startReadingSSE(readCtx, s.body, evtChannel, errChannel)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errChannel:
+			// The reader sends the last event before the error, so it may still be buffered.
+			select {
+			case event := <-evtChannel:
+				if err := s.deliver(ctx, handler, event); err != nil {
+					return err
+				}
+			default:
+			}
+			return err
+		case event := <-evtChannel:
+			if err := s.deliver(ctx, handler, event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// deliver passes an event to the handler, unless it is a comment, has no data or was already delivered.
+func (s *eventStream) deliver(ctx context.Context, handler EventStreamHandler, event *sse.Event) error {
+	if event.Retry != 0 {
+		s.client.logger.Debug("received retry event", slog.Int("retry", event.Retry))
+		s.retry = time.Duration(event.Retry) * time.Millisecond
+	}
+	if event.IsComment() {
+		s.client.logger.Debug("received comment", slog.String("comment", event.Comment))
+		return nil
+	}
+	if len(event.Data) == 0 {
+		return nil
+	}
+	id := parseEventIdentity(event)
+	if s.checkpoint.replaying {
+		if s.checkpoint.isDuplicate(id) {
+			s.client.logger.Debug("skipping duplicate event", slog.String("id", id.ID), slog.String("ulid", id.ULID))
+			s.stats.duplicates.Add(1)
+			return nil
+		}
+		s.checkpoint.replaying = false
+	}
+	if err := handler(ctx, event); err != nil {
+		return &handlerError{err: err}
+	}
+	s.checkpoint.advance(id, event.ID)
+	s.failures = 0
+	s.stats.delivered.Add(1)
+	return nil
+}
+
+// reconnect re-opens the stream with backoff, resuming from the last delivered event.
+// Errors that the retry policy does not retry, such as client errors, are returned right away.
+func (s *eventStream) reconnect(ctx context.Context, cause error) error {
+	policy := s.client.reconnectPolicy
+	for {
+		if policy.MaxAttempts >= 0 && s.failures >= policy.MaxAttempts {
+			return fmt.Errorf("reconnecting to stream after %d attempts: %w", s.failures, cause)
+		}
+		s.failures++
+		attempt := s.failures
+		delay := policy.delay(attempt, s.retry)
+		s.client.logger.Warn(
+			"reconnecting to stream",
+			slog.String("path", s.path),
+			slog.Int("attempt", attempt),
+			slog.Duration("delay", delay),
+			slog.String("since id", s.checkpoint.last.ID),
+			slog.String("since ulid", s.checkpoint.last.ULID),
+			slog.Any("error", cause),
+		)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		err := s.connect(ctx)
+		if err == nil {
+			s.checkpoint.replaying = true
+			s.stats.reconnects.Add(1)
+			return nil
+		}
+		// Client errors such as an invalid token or an unknown path will not go away by reconnecting.
+		if !s.client.retryPolicy.shouldRetry(ctx, err) {
+			return err
+		}
+		cause = err
+	}
+}
+
+func (c *Client) listenToSSE(ctx context.Context, path string, params any, opts ...model.RequestOption) (io.ReadCloser, error) {
+	uri, err := c.encoder.EncodeParams(path, params)
+	if err != nil {
+		return nil, err
+	}
+	req := &Request{
+		Method:  http.MethodGet,
+		URI:     uri,
+		Options: mergeOptions(opts...),
+		Stream:  true,
+	}
+	res, err := c.roundTrip(ctx, req, c.openStream)
+	if err != nil {
+		// The body of an error response is not parsed, so it must be released before reconnecting.
+		discardBody(res)
+		return nil, fmt.Errorf("executing request: %w", err)
+	}
+	return res.RawBody(), nil
+}
+
+func (c *Client) openStream(ctx context.Context, r *Request) (*resty.Response, error) {
+	req := c.HTTP.R()
+	req.SetQueryParamsFromValues(r.Options.QueryParams)
+	req.SetHeaderMultiValues(r.Options.Headers)
+	req.SetError(&alpacaerrors.ResponseError{})
+	req.SetHeader("Accept", "text/event-stream")
+	req.SetHeader("Connection", "keep-alive")
+	req.SetHeader("Cache-Control", "no-cache")
+	// Not parsing the response enables us to read the raw response body without it
+	// getting closed. Hence, allowing the SSE client to keep reading from the stream.
+	req.SetDoNotParseResponse(true)
+
+	// Streams are long-lived, so the request is only bound to the context and has no timeout.
+	return c.executeWithRetry(ctx, req, r.Method, r.URI, r.Options, 0)
+}
+
+func (c *Client) startReadingSSE(ctx context.Context, r io.ReadCloser, evtCh chan<- *sse.Event, errCh chan<- error) {
+	decoder := sse.NewDecoder(r)
+
+	for {
+		evt, err := decoder.Decode()
+		if err != nil {
+			// An event stream never ends on its own, so EOF means the server dropped the connection.
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			select {
+			case errCh <- fmt.Errorf("reading message: %w", err):
+			case <-ctx.Done():
+				c.logger.Debug("context cancelled", slog.Any("error", ctx.Err()))
+			}
+			return
+		}
+		if evt.IsEmpty() {
+			continue
+		}
+		select {
+		case evtCh <- evt:
+		case <-ctx.Done():
+			c.logger.Debug("context cancelled", slog.Any("error", ctx.Err()))
+			return
+		}
+	}
+}
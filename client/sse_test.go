@@ -0,0 +1,396 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	alpacaerrors "go.tradeforge.dev/alpaca/errors"
+	"go.tradeforge.dev/alpaca/model"
+	"go.tradeforge.dev/alpaca/sse"
+)
+
+var errStopStream = errors.New("stop stream")
+
+// testEvent is an event sent by sseServer. Its SSE id is the event_id.
+type testEvent struct {
+	ID   int    `json:"event_id"`
+	ULID string `json:"event_ulid,omitempty"`
+}
+
+// sseConnection is what sseServer sends on a connection: either an error status or events
+// after which the connection is dropped, or held open if hold is set.
+type sseConnection struct {
+	status int
+	retry  int
+	events []testEvent
+	hold   bool
+}
+
+// sseRequest is a request received by sseServer.
+type sseRequest struct {
+	query       map[string]string
+	lastEventID string
+}
+
+// sseServer serves the given connections in turn, repeating the last one, and records the requests.
+type sseServer struct {
+	*httptest.Server
+	connections []sseConnection
+
+	mu       sync.Mutex
+	requests []sseRequest
+}
+
+func newSSEServer(t *testing.T, connections ...sseConnection) *sseServer {
+	t.Helper()
+	s := &sseServer{connections: connections}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *sseServer) serve(w http.ResponseWriter, r *http.Request) {
+	query := map[string]string{}
+	for k := range r.URL.Query() {
+		query[k] = r.URL.Query().Get(k)
+	}
+	s.mu.Lock()
+	s.requests = append(s.requests, sseRequest{query: query, lastEventID: r.Header.Get("Last-Event-ID")})
+	conn := s.connections[min(len(s.requests), len(s.connections))-1]
+	s.mu.Unlock()
+
+	if conn.status != 0 {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(conn.status)
+		_, _ = io.WriteString(w, `{"message":"`+http.StatusText(conn.status)+`"}`)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.WriteHeader(http.StatusOK)
+	if conn.retry != 0 {
+		_, _ = fmt.Fprintf(w, "retry: %d\n", conn.retry)
+	}
+	for _, event := range conn.events {
+		data, _ := json.Marshal(event)
+		_, _ = fmt.Fprintf(w, "id: %d\ndata: %s\n\n", event.ID, data)
+	}
+	w.(http.Flusher).Flush()
+	if conn.hold {
+		<-r.Context().Done()
+	}
+	// Returning drops the connection in the middle of the stream.
+}
+
+func (s *sseServer) received() []sseRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.requests)
+}
+
+// newStreamTestClient returns a client that reconnects quickly and without jitter.
+func newStreamTestClient(t *testing.T, url string) *Client {
+	t.Helper()
+	c := newTestClient(t, url)
+	c.SetReconnectPolicy(ReconnectPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    10 * time.Millisecond,
+	})
+	return c
+}
+
+// collectEvents returns a handler that records the event IDs and stops the stream after the given ID.
+func collectEvents(ids *[]int, stopAfter int) EventStreamHandler {
+	return func(_ context.Context, event *sse.Event) error {
+		e := testEvent{}
+		if err := json.Unmarshal(event.Data, &e); err != nil {
+			return err
+		}
+		*ids = append(*ids, e.ID)
+		if e.ID == stopAfter {
+			return errStopStream
+		}
+		return nil
+	}
+}
+
+func TestListenResumesAfterReconnect(t *testing.T) {
+	tests := []struct {
+		name        string
+		connections []sseConnection
+		wantQuery   map[string]string
+	}{
+		{
+			name: "ULID",
+			connections: []sseConnection{
+				{events: []testEvent{{ID: 1, ULID: "01A"}, {ID: 2, ULID: "01B"}, {ID: 3, ULID: "01C"}}},
+				{events: []testEvent{{ID: 2, ULID: "01B"}, {ID: 3, ULID: "01C"}, {ID: 4, ULID: "01D"}}},
+			},
+			wantQuery: map[string]string{"since_ulid": "01C"},
+		},
+		{
+			name: "sequential ID",
+			connections: []sseConnection{
+				{events: []testEvent{{ID: 1}, {ID: 2}, {ID: 3}}},
+				{events: []testEvent{{ID: 3}, {ID: 4}}},
+			},
+			wantQuery: map[string]string{"since_id": "3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newSSEServer(t, tt.connections...)
+			c := newStreamTestClient(t, srv.URL)
+
+			var ids []int
+			params := model.WatchParams{Since: "2024-01-01"}
+			err := c.Listen(context.Background(), "/events", params, collectEvents(&ids, 4))
+			if !errors.Is(err, errStopStream) {
+				t.Fatalf("got error %v, want %v", err, errStopStream)
+			}
+			if want := []int{1, 2, 3, 4}; !slices.Equal(ids, want) {
+				t.Errorf("got events %v, want %v", ids, want)
+			}
+
+			requests := srv.received()
+			if len(requests) != 2 {
+				t.Fatalf("got %d requests, want 2", len(requests))
+			}
+			if want := map[string]string{"since": "2024-01-01"}; !mapsEqual(requests[0].query, want) {
+				t.Errorf("first request: got query %v, want %v", requests[0].query, want)
+			}
+			if requests[0].lastEventID != "" {
+				t.Errorf("first request: got Last-Event-ID %q, want none", requests[0].lastEventID)
+			}
+			if !mapsEqual(requests[1].query, tt.wantQuery) {
+				t.Errorf("reconnect: got query %v, want %v", requests[1].query, tt.wantQuery)
+			}
+			if requests[1].lastEventID != "3" {
+				t.Errorf("reconnect: got Last-Event-ID %q, want 3", requests[1].lastEventID)
+			}
+		})
+	}
+}
+
+func TestListenServerRetryOverridesBaseDelay(t *testing.T) {
+	srv := newSSEServer(t,
+		sseConnection{retry: 5, events: []testEvent{{ID: 1}}},
+		sseConnection{events: []testEvent{{ID: 2}}},
+	)
+	c := newStreamTestClient(t, srv.URL)
+	c.SetReconnectPolicy(ReconnectPolicy{MaxAttempts: 1, BaseDelay: time.Hour, MaxDelay: time.Hour})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var ids []int
+	err := c.Listen(ctx, "/events", model.WatchParams{}, collectEvents(&ids, 2))
+	if !errors.Is(err, errStopStream) {
+		t.Fatalf("got error %v, want %v: the base delay was not overridden", err, errStopStream)
+	}
+}
+
+func TestListenStopsOnClientError(t *testing.T) {
+	tests := []struct {
+		name         string
+		connections  []sseConnection
+		wantRequests int
+		wantStatus   int
+	}{
+		{
+			name:         "first connection",
+			connections:  []sseConnection{{status: http.StatusNotFound}},
+			wantRequests: 1,
+			wantStatus:   http.StatusNotFound,
+		},
+		{
+			name: "reconnect",
+			connections: []sseConnection{
+				{events: []testEvent{{ID: 1}}},
+				{status: http.StatusUnauthorized},
+			},
+			wantRequests: 2,
+			wantStatus:   http.StatusUnauthorized,
+		},
+		{
+			name: "after server errors",
+			connections: []sseConnection{
+				{events: []testEvent{{ID: 1}}},
+				{status: http.StatusServiceUnavailable},
+				{status: http.StatusForbidden},
+			},
+			wantRequests: 3,
+			wantStatus:   http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newSSEServer(t, tt.connections...)
+			c := newStreamTestClient(t, srv.URL)
+			c.SetReconnectPolicy(ReconnectPolicy{MaxAttempts: 10, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond})
+
+			var ids []int
+			err := c.Listen(context.Background(), "/events", model.WatchParams{}, collectEvents(&ids, 0))
+			responseError, ok := alpacaerrors.AsResponseError(err)
+			if !ok {
+				t.Fatalf("got error %v, want a response error", err)
+			}
+			if responseError.StatusCode != tt.wantStatus {
+				t.Errorf("got status %d, want %d", responseError.StatusCode, tt.wantStatus)
+			}
+			if got := len(srv.received()); got != tt.wantRequests {
+				t.Errorf("got %d requests, want %d", got, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestReconnectPolicyDelay(t *testing.T) {
+	policy := ReconnectPolicy{BaseDelay: time.Second, MaxDelay: 4 * time.Second}
+	tests := []struct {
+		name        string
+		attempt     int
+		serverRetry time.Duration
+		want        time.Duration
+	}{
+		{name: "base delay", attempt: 1, want: time.Second},
+		{name: "backoff", attempt: 2, want: 2 * time.Second},
+		{name: "capped", attempt: 4, want: 4 * time.Second},
+		{name: "server retry", attempt: 1, serverRetry: 100 * time.Millisecond, want: 100 * time.Millisecond},
+		{name: "server retry backoff", attempt: 3, serverRetry: 100 * time.Millisecond, want: 400 * time.Millisecond},
+		{name: "server retry above max delay", attempt: 2, serverRetry: 10 * time.Second, want: 10 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.delay(tt.attempt, tt.serverRetry); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckpointIsDuplicate(t *testing.T) {
+	tests := []struct {
+		name string
+		last eventIdentity
+		id   eventIdentity
+		want bool
+	}{
+		{name: "no checkpoint", id: eventIdentity{ID: "1"}, want: false},
+		{name: "older ULID", last: eventIdentity{ULID: "01B"}, id: eventIdentity{ULID: "01A"}, want: true},
+		{name: "same ULID", last: eventIdentity{ULID: "01B"}, id: eventIdentity{ULID: "01B"}, want: true},
+		{name: "newer ULID", last: eventIdentity{ULID: "01B"}, id: eventIdentity{ULID: "01C"}, want: false},
+		{name: "ULID preferred over ID", last: eventIdentity{ID: "1", ULID: "01B"}, id: eventIdentity{ID: "9", ULID: "01A"}, want: true},
+		{name: "older numeric ID", last: eventIdentity{ID: "10"}, id: eventIdentity{ID: "9"}, want: true},
+		{name: "newer numeric ID", last: eventIdentity{ID: "9"}, id: eventIdentity{ID: "10"}, want: false},
+		{name: "same opaque ID", last: eventIdentity{ID: "abc"}, id: eventIdentity{ID: "abc"}, want: true},
+		{name: "other opaque ID", last: eventIdentity{ID: "abc"}, id: eventIdentity{ID: "abd"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := checkpoint{last: tt.last}
+			if got := c.isDuplicate(tt.id); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckpointResume(t *testing.T) {
+	c := checkpoint{}
+	params := model.WatchParams{Since: "2024-01-01"}
+	if got := c.resume(params); got != params {
+		t.Errorf("without checkpoint: got %+v, want %+v", got, params)
+	}
+
+	c.advance(eventIdentity{ID: "7"}, "7")
+	if got, want := c.resume(params), (model.WatchParams{SinceID: "7"}); got != want {
+		t.Errorf("after ID: got %+v, want %+v", got, want)
+	}
+	c.advance(eventIdentity{ID: "8", ULID: "01H"}, "")
+	if got, want := c.resume(params), (model.WatchParams{SinceULID: "01H"}); got != want {
+		t.Errorf("after ULID: got %+v, want %+v", got, want)
+	}
+	if c.lastEventID != "7" {
+		t.Errorf("got Last-Event-ID %q, want it kept when the event has no SSE id", c.lastEventID)
+	}
+
+	type plainParams struct{ Symbol string }
+	if got, want := c.resume(plainParams{Symbol: "AAPL"}), (plainParams{Symbol: "AAPL"}); got != want {
+		t.Errorf("not resumable: got %+v, want %+v", got, want)
+	}
+}
+
+func mapsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSubscribeRetriesInBackground(t *testing.T) {
+	srv := newSSEServer(t, sseConnection{status: http.StatusServiceUnavailable})
+	c := newStreamTestClient(t, srv.URL)
+	c.SetReconnectPolicy(ReconnectPolicy{MaxAttempts: 10, BaseDelay: time.Hour, MaxDelay: time.Hour})
+
+	var ids []int
+	sub, err := c.Subscribe(context.Background(), "/events", model.WatchParams{}, collectEvents(&ids, 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The first reconnect waits for an hour, so Stop must interrupt it.
+	sub.Stop()
+	if err := sub.Err(); err != nil {
+		t.Errorf("got error %v after Stop, want none", err)
+	}
+}
+
+func TestSubscribeReportsReconnectFailure(t *testing.T) {
+	srv := newSSEServer(t,
+		sseConnection{status: http.StatusServiceUnavailable},
+		sseConnection{status: http.StatusUnauthorized},
+	)
+	c := newStreamTestClient(t, srv.URL)
+
+	var ids []int
+	sub, err := c.Subscribe(context.Background(), "/events", model.WatchParams{}, collectEvents(&ids, 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	select {
+	case <-sub.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not end")
+	}
+	if responseError, ok := alpacaerrors.AsResponseError(sub.Err()); !ok || responseError.StatusCode != http.StatusUnauthorized {
+		t.Errorf("got error %v, want a %d response error", sub.Err(), http.StatusUnauthorized)
+	}
+}
+
+func TestSubscribeFailsFastOnClientError(t *testing.T) {
+	srv := newSSEServer(t, sseConnection{status: http.StatusUnauthorized})
+	c := newStreamTestClient(t, srv.URL)
+
+	var ids []int
+	sub, err := c.Subscribe(context.Background(), "/events", model.WatchParams{}, collectEvents(&ids, 0))
+	if err == nil {
+		sub.Stop()
+		t.Fatal("expected an error")
+	}
+	if got := len(srv.received()); got != 1 {
+		t.Errorf("got %d requests, want 1", got)
+	}
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"go.tradeforge.dev/alpaca/model"
+	"go.tradeforge.dev/alpaca/sse"
+)
+
+const DefaultStreamBufferSize = 64
+
+// ErrStreamOverflow is returned when a stream with the OverflowFail policy cannot keep up with incoming events.
+var ErrStreamOverflow = errors.New("stream buffer overflow")
+
+// OverflowPolicy defines what a stream does when its event buffer is full.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock stops reading from the event stream until the consumer catches up.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest discards the oldest buffered event to make room for the new one.
+	OverflowDropOldest
+	// OverflowFail ends the stream with ErrStreamOverflow.
+	OverflowFail
+)
+
+// StreamOptions configure a typed event stream.
+type StreamOptions struct {
+	// BufferSize is the capacity of the events and errors channels. Defaults to DefaultStreamBufferSize.
+	BufferSize int
+	// Overflow is the policy applied when the events channel is full.
+	Overflow OverflowPolicy
+}
+
+// Stream is a typed SSE stream that decodes every event into T and delivers it over a channel.
+type Stream[T any] struct {
+	*Subscription
+
+	events chan T
+	errors chan error
+	policy OverflowPolicy
+	logger *slog.Logger
+}
+
+// NewStream subscribes to an SSE event data stream and decodes the data of every event into T.
+// This is a non-blocking call.
+//
+// The events and errors channels are closed once the stream has ended. Errors receives events that
+// could not be decoded and the error that ended the stream, if any. When the errors channel is full,
+// further errors are dropped; the terminal error is always available through Err.
+func NewStream[T any](
+	ctx context.Context,
+	c *Client,
+	path string,
+	params any,
+	options StreamOptions,
+	opts ...model.RequestOption,
+) (*Stream[T], error) {
+	if options.BufferSize <= 0 {
+		options.BufferSize = DefaultStreamBufferSize
+	}
+	s := &Stream[T]{
+		events: make(chan T, options.BufferSize),
+		errors: make(chan error, options.BufferSize),
+		policy: options.Overflow,
+		logger: c.logger,
+	}
+	sub, err := c.Subscribe(ctx, path, params, s.handle, opts...)
+	if err != nil {
+		return nil, err
+	}
+	s.Subscription = sub
+
+	go func() {
+		<-sub.Done()
+		if err := sub.Err(); err != nil {
+			s.sendError(err)
+		}
+		close(s.events)
+		close(s.errors)
+	}()
+
+	return s, nil
+}
+
+// Events returns the channel of decoded events.
+func (s *Stream[T]) Events() <-chan T {
+	return s.events
+}
+
+// Errors returns the channel of stream errors.
+func (s *Stream[T]) Errors() <-chan error {
+	return s.errors
+}
+
+func (s *Stream[T]) handle(ctx context.Context, event *sse.Event) error {
+	var v T
+	if err := json.Unmarshal(event.Data, &v); err != nil {
+		s.sendError(fmt.Errorf("unmarshalling event: %w", err))
+		return nil
+	}
+
+	switch s.policy {
+	case OverflowDropOldest:
+		for {
+			select {
+			case s.events <- v:
+				return nil
+			default:
+			}
+			select {
+			case <-s.events:
+				s.logger.Warn("stream buffer full, dropping oldest event")
+			default:
+			}
+		}
+	case OverflowFail:
+		select {
+		case s.events <- v:
+			return nil
+		default:
+			return ErrStreamOverflow
+		}
+	default:
+		select {
+		case s.events <- v:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (s *Stream[T]) sendError(err error) {
+	select {
+	case s.errors <- err:
+	default:
+		s.logger.Warn("stream errors buffer full, dropping error", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"go.tradeforge.dev/alpaca/model"
+)
+
+// newTestStream opens a stream with a buffer of two events on a server that sends five events
+// and keeps the connection open.
+func newTestStream(t *testing.T, overflow OverflowPolicy) *Stream[testEvent] {
+	t.Helper()
+	srv := newSSEServer(t, sseConnection{
+		events: []testEvent{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5}},
+		hold:   true,
+	})
+	c := newStreamTestClient(t, srv.URL)
+	s, err := NewStream[testEvent](context.Background(), c, "/events", model.WatchParams{}, StreamOptions{
+		BufferSize: 2,
+		Overflow:   overflow,
+	})
+	if err != nil {
+		t.Fatalf("opening stream: %v", err)
+	}
+	t.Cleanup(s.Stop)
+	return s
+}
+
+// eventually fails the test if the condition is not met within a second.
+func eventually(t *testing.T, condition func() bool, msg string) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal(msg)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func receiveIDs(s *Stream[testEvent], n int) []int {
+	ids := make([]int, 0, n)
+	for event := range s.Events() {
+		ids = append(ids, event.ID)
+		if len(ids) == n {
+			break
+		}
+	}
+	return ids
+}
+
+func TestStreamOverflowBlock(t *testing.T) {
+	s := newTestStream(t, OverflowBlock)
+
+	eventually(t, func() bool { return len(s.Events()) == 2 }, "buffer not filled")
+	// The handler is blocked on the third event until the consumer catches up.
+	time.Sleep(20 * time.Millisecond)
+	if got := s.Stats().Delivered; got != 2 {
+		t.Fatalf("got %d delivered events while the buffer is full, want 2", got)
+	}
+	if got, want := receiveIDs(s, 5), []int{1, 2, 3, 4, 5}; !slices.Equal(got, want) {
+		t.Errorf("got events %v, want %v", got, want)
+	}
+	if err := s.Err(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestStreamOverflowDropOldest(t *testing.T) {
+	s := newTestStream(t, OverflowDropOldest)
+
+	eventually(t, func() bool { return s.Stats().Delivered == 5 }, "events not delivered")
+	s.Stop()
+	if got, want := receiveIDs(s, 5), []int{4, 5}; !slices.Equal(got, want) {
+		t.Errorf("got events %v, want %v", got, want)
+	}
+	if err := s.Err(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestStreamOverflowFail(t *testing.T) {
+	s := newTestStream(t, OverflowFail)
+
+	select {
+	case <-s.Done():
+	case <-time.After(time.Second):
+		t.Fatal("stream did not fail")
+	}
+	if err := s.Err(); !errors.Is(err, ErrStreamOverflow) {
+		t.Fatalf("got error %v, want %v", err, ErrStreamOverflow)
+	}
+	if got, want := receiveIDs(s, 5), []int{1, 2}; !slices.Equal(got, want) {
+		t.Errorf("got events %v, want %v", got, want)
+	}
+	var errs []error
+	for err := range s.Errors() {
+		errs = append(errs, err)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], ErrStreamOverflow) {
+		t.Errorf("got errors %v, want [%v]", errs, ErrStreamOverflow)
+	}
+}
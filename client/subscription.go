@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// Subscription is a handle to a running, non-blocking SSE subscription.
+type Subscription struct {
+	stream *eventStream
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+// SubscriptionStats are the delivery counters of a subscription.
+type SubscriptionStats struct {
+	// Delivered is the number of events successfully handled.
+	Delivered uint64
+	// Duplicates is the number of events skipped because they were already delivered before a reconnect.
+	Duplicates uint64
+	// Reconnects is the number of successful reconnections.
+	Reconnects uint64
+}
+
+// streamStats holds the counters updated by a running stream.
+type streamStats struct {
+	delivered  atomic.Uint64
+	duplicates atomic.Uint64
+	reconnects atomic.Uint64
+}
+
+func newSubscription(stream *eventStream, cancel context.CancelFunc) *Subscription {
+	return &Subscription{
+		stream: stream,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+}
+
+// Stop stops the subscription and waits until the event currently being handled, if any, is done.
+// It is safe to call Stop multiple times.
+//
+// Stop must not be called from the handler of the subscription, since it would wait for the handler itself
+// to return. Use Cancel there instead.
+func (s *Subscription) Stop() {
+	s.cancel()
+	<-s.done
+}
+
+// Cancel stops the subscription without waiting for the event currently being handled.
+// Unlike Stop, it can be called from the handler. Use Done to wait until the subscription has ended.
+func (s *Subscription) Cancel() {
+	s.cancel()
+}
+
+// Done returns a channel that is closed once the subscription has ended.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns the reason the subscription ended.
+// It returns nil while the subscription is running and when it was stopped through Stop or its context.
+func (s *Subscription) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Stats returns the delivery counters of the subscription.
+func (s *Subscription) Stats() SubscriptionStats {
+	return SubscriptionStats{
+		Delivered:  s.stream.stats.delivered.Load(),
+		Duplicates: s.stream.stats.duplicates.Load(),
+		Reconnects: s.stream.stats.reconnects.Load(),
+	}
+}
+
+func (s *Subscription) finish(err error) {
+	s.mu.Lock()
+	s.err = err
+	s.mu.Unlock()
+	close(s.done)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-playground/form/v4"
 	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
 )
 
 // Encoder defines a path and query param encoder that plays nicely with the Polygon REST API.
@@ -92,6 +93,11 @@ func newEncoder(tagName string) *form.Encoder {
 	e := form.NewEncoder()
 	e.SetMode(form.ModeExplicit)
 	e.SetTagName(tagName)
+	e.RegisterCustomTypeFunc(encodeDecimal, decimal.Decimal{})
 
 	return e
 }
+
+func encodeDecimal(x any) ([]string, error) {
+	return []string{x.(decimal.Decimal).String()}, nil
+}
@@ -1,9 +1,10 @@
 module go.tradeforge.dev/alpaca
 
-go 1.22.0
+go 1.23.0
 
 require (
 	github.com/alpacahq/alpaca-trade-api-go/v3 v3.4.0
+	github.com/gabriel-vasile/mimetype v1.4.3
 	github.com/go-playground/form/v4 v4.2.1
 	github.com/go-playground/validator/v10 v10.22.1
 	github.com/go-resty/resty/v2 v2.11.0
@@ -13,7 +14,6 @@ require (
 
 require (
 	cloud.google.com/go v0.114.0 // indirect
-	github.com/gabriel-vasile/mimetype v1.4.3 // indirect
 	github.com/go-playground/locales v0.14.1 // indirect
 	github.com/go-playground/universal-translator v0.18.1 // indirect
 	github.com/josharian/intern v1.0.0 // indirect
@@ -29,10 +29,16 @@ type Config struct {
 }
 
 type StreamConfig struct {
-	BaseURL              string         `env:"ALPACA_MARKET_STREAM_API_URL" validate:"required,url"`
+	// BaseURL is the base URL of the stock stream. It defaults to the URL of the stream client.
+	BaseURL              string         `env:"ALPACA_MARKET_STREAM_API_URL" validate:"omitempty,url"`
 	Feed                 string         `env:"ALPACA_MARKET_STREAM_FEED" validate:"required"`
 	ReconnectMaxAttempts *int           `env:"ALPACA_MARKET_STREAM_RECONNECT_MAX_ATTEMPTS" envDefault:"5"`
 	ReconnectInterval    *time.Duration `env:"ALPACA_MARKET_STREAM_RECONNECT_INTERVAL" envDefault:"5s"`
+	// CryptoBaseURL is the base URL of the crypto stream, which the crypto location is appended to.
+	// It defaults to the URL of the stream client.
+	CryptoBaseURL string `env:"ALPACA_MARKET_CRYPTO_STREAM_API_URL" validate:"omitempty,url"`
+	// CryptoFeed is the crypto location streamed by the crypto client. It defaults to model.CryptoLocationUS.
+	CryptoFeed string `env:"ALPACA_MARKET_CRYPTO_STREAM_FEED" envDefault:"us"`
 }
 
 // Client defines a client for the Alpaca Broker API.
@@ -40,7 +46,10 @@ type Client struct {
 	*client.Client
 
 	StocksClient
+	CryptoClient
+	OptionsClient
 	NewsClient
+	CorporateActionsClient
 }
 
 // NewClient returns a new client with the specified API key and config.
@@ -55,9 +64,40 @@ func NewClient(
 	c.SetHeader(apiKeyHeader, config.APIKey)
 	c.SetHeader(apiSecretHeader, config.APISecret)
 
-	streamClient := stream.NewStocksClient(
-		config.Stream.Feed,
-		stream.WithBaseURL(config.Stream.BaseURL),
+	var stockOpts []stream.StockOption
+	for _, o := range streamOptions(config, logger, config.Stream.BaseURL, config.Stream.Feed) {
+		stockOpts = append(stockOpts, o)
+	}
+	streamClient := stream.NewStocksClient(config.Stream.Feed, stockOpts...)
+
+	cryptoFeed := cryptoLocation(config.Stream.CryptoFeed)
+	var cryptoOpts []stream.CryptoOption
+	for _, o := range streamOptions(config, logger, config.Stream.CryptoBaseURL, cryptoFeed) {
+		cryptoOpts = append(cryptoOpts, o)
+	}
+	cryptoStreamClient := stream.NewCryptoClient(cryptoFeed, cryptoOpts...)
+	return &Client{
+		Client: c,
+		StocksClient: StocksClient{
+			Client: c,
+			stream: streamClient,
+			logger: logger,
+		},
+		CryptoClient: CryptoClient{
+			Client: c,
+			stream: cryptoStreamClient,
+			logger: logger,
+		},
+		OptionsClient:          OptionsClient{Client: c},
+		NewsClient:             NewsClient{Client: c},
+		CorporateActionsClient: CorporateActionsClient{Client: c},
+	}
+}
+
+// streamOptions returns the options shared by the stocks and crypto streams.
+// The base URL is only set if it is not empty, so that the stream client default applies otherwise.
+func streamOptions(config Config, logger *slog.Logger, baseURL, feed string) []stream.Option {
+	opts := []stream.Option{
 		stream.WithCredentials(
 			config.APIKey,
 			config.APISecret,
@@ -76,21 +116,16 @@ func NewClient(
 		stream.WithConnectCallback(
 			func() {
 				logger.Debug("connected to stream",
-					slog.String("url", config.Stream.BaseURL),
-					slog.String("feed", config.Stream.Feed),
+					slog.String("url", baseURL),
+					slog.String("feed", feed),
 				)
 			}),
 		stream.WithLogger(wrapLogger(logger)),
-	)
-	return &Client{
-		Client: c,
-		StocksClient: StocksClient{
-			Client: c,
-			stream: streamClient,
-			logger: logger,
-		},
-		NewsClient: NewsClient{Client: c},
 	}
+	if baseURL != "" {
+		opts = append(opts, stream.WithBaseURL(baseURL))
+	}
+	return opts
 }
 
 func wrapLogger(logger *slog.Logger) *defaultLogger {
@@ -0,0 +1,24 @@
+package market
+
+import (
+	"context"
+	"net/http"
+
+	"go.tradeforge.dev/alpaca/client"
+	"go.tradeforge.dev/alpaca/model"
+)
+
+const (
+	GetCorporateActionsPath = "/v1beta1/corporate-actions"
+)
+
+type CorporateActionsClient struct {
+	*client.Client
+}
+
+// GetCorporateActions returns the corporate actions matching the params, grouped by type.
+func (cc *CorporateActionsClient) GetCorporateActions(ctx context.Context, params model.GetCorporateActionsParams, opts ...model.RequestOption) (*model.GetCorporateActionsResponse, error) {
+	res := &model.GetCorporateActionsResponse{}
+	err := cc.Call(ctx, http.MethodGet, GetCorporateActionsPath, params, res, opts...)
+	return res, err
+}
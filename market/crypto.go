@@ -0,0 +1,293 @@
+package market
+
+import (
+	"context"
+	"iter"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/alpacahq/alpaca-trade-api-go/v3/marketdata/stream"
+	"github.com/shopspring/decimal"
+
+	"go.tradeforge.dev/alpaca/client"
+	"go.tradeforge.dev/alpaca/model"
+)
+
+const (
+	GetCryptoBarsPath             = "/v1beta3/crypto/:loc/bars"
+	GetLatestCryptoQuotesPath     = "/v1beta3/crypto/:loc/latest/quotes"
+	GetLatestCryptoTradesPath     = "/v1beta3/crypto/:loc/latest/trades"
+	GetLatestCryptoOrderbooksPath = "/v1beta3/crypto/:loc/latest/orderbooks"
+	GetCryptoSnapshotsPath        = "/v1beta3/crypto/:loc/snapshots"
+)
+
+// CryptoClient is a client for the crypto API.
+// Crypto markets are open around the clock, so the stream does not go quiet outside of stock market hours.
+// The stream is bound to the location set by StreamConfig.CryptoFeed, whereas requests can target any location.
+// The stream connection is shared by every subscription and owned by the client; close it with Close.
+type CryptoClient struct {
+	*client.Client
+	stream     *stream.CryptoClient
+	connection streamConnection
+	logger     *slog.Logger
+}
+
+func (cc *CryptoClient) GetCryptoBars(ctx context.Context, params model.GetCryptoBarsParams, opts ...model.RequestOption) (*model.GetCryptoBarsResponse, error) {
+	params.Loc = cryptoLocation(params.Loc)
+	res := &model.GetCryptoBarsResponse{}
+	err := cc.Call(ctx, http.MethodGet, GetCryptoBarsPath, params, res, opts...)
+	return res, err
+}
+
+// IterCryptoBars returns an iterator over the crypto bars matching the params, following the page tokens.
+// Within a page, bars are yielded symbol by symbol in alphabetical order, with their Symbol field set.
+// Pages are fetched lazily; use model.WithPrefetch to fetch the next page in advance.
+func (cc *CryptoClient) IterCryptoBars(ctx context.Context, params model.GetCryptoBarsParams, opts ...model.RequestOption) iter.Seq2[model.CryptoBar, error] {
+	return client.Paginate(
+		ctx,
+		params.PageToken,
+		func(ctx context.Context, pageToken *string) (client.Page[model.CryptoBar, *string], error) {
+			params.PageToken = pageToken
+			res, err := cc.GetCryptoBars(ctx, params, opts...)
+			if err != nil {
+				return client.Page[model.CryptoBar, *string]{}, err
+			}
+			var bars []model.CryptoBar
+			for _, symbol := range slices.Sorted(maps.Keys(res.Bars)) {
+				for _, bar := range res.Bars[symbol] {
+					bar.Symbol = symbol
+					bars = append(bars, bar)
+				}
+			}
+			return client.Page[model.CryptoBar, *string]{
+				Items:   bars,
+				Next:    &res.NextPageToken,
+				HasNext: res.NextPageToken != "",
+			}, nil
+		},
+		opts...,
+	)
+}
+
+func (cc *CryptoClient) GetLatestCryptoQuotes(ctx context.Context, params model.GetLatestCryptoQuotesParams, opts ...model.RequestOption) (*model.GetLatestCryptoQuotesResponse, error) {
+	params.Loc = cryptoLocation(params.Loc)
+	res := &model.GetLatestCryptoQuotesResponse{}
+	err := cc.Call(ctx, http.MethodGet, GetLatestCryptoQuotesPath, params, res, opts...)
+	return res, err
+}
+
+func (cc *CryptoClient) GetLatestCryptoTrades(ctx context.Context, params model.GetLatestCryptoTradesParams, opts ...model.RequestOption) (*model.GetLatestCryptoTradesResponse, error) {
+	params.Loc = cryptoLocation(params.Loc)
+	res := &model.GetLatestCryptoTradesResponse{}
+	err := cc.Call(ctx, http.MethodGet, GetLatestCryptoTradesPath, params, res, opts...)
+	return res, err
+}
+
+// GetLatestCryptoOrderbooks returns the full bid and ask ladders of the specified symbols.
+func (cc *CryptoClient) GetLatestCryptoOrderbooks(ctx context.Context, params model.GetLatestCryptoOrderbooksParams, opts ...model.RequestOption) (*model.GetLatestCryptoOrderbooksResponse, error) {
+	params.Loc = cryptoLocation(params.Loc)
+	res := &model.GetLatestCryptoOrderbooksResponse{}
+	err := cc.Call(ctx, http.MethodGet, GetLatestCryptoOrderbooksPath, params, res, opts...)
+	return res, err
+}
+
+func (cc *CryptoClient) GetCryptoSnapshots(ctx context.Context, params model.GetCryptoSnapshotsParams, opts ...model.RequestOption) (*model.GetCryptoSnapshotsResponse, error) {
+	params.Loc = cryptoLocation(params.Loc)
+	res := &model.GetCryptoSnapshotsResponse{}
+	err := cc.Call(ctx, http.MethodGet, GetCryptoSnapshotsPath, params, res, opts...)
+	return res, err
+}
+
+type CryptoBarHandler func(context.Context, *model.CryptoBar) error
+
+type CryptoQuoteHandler func(context.Context, *model.SymbolCryptoQuote) error
+
+type CryptoTradeHandler func(context.Context, *model.SymbolCryptoTrade) error
+
+type OrderbookHandler func(context.Context, *model.Orderbook) error
+
+// SubscribeToCryptoBars subscribes to the minute bars of the specified symbols.
+// This is a non-blocking call. The shared connection outlives ctx and is only closed by Close.
+func (cc *CryptoClient) SubscribeToCryptoBars(ctx context.Context, params model.StreamCryptoUpdatesParams, handle CryptoBarHandler) error {
+	if err := cc.connect(ctx); err != nil {
+		return err
+	}
+	return cc.stream.SubscribeToBars(
+		func(bar stream.CryptoBar) {
+			cc.handle(ctx, "crypto bar", func(ctx context.Context) error {
+				return handle(ctx, newCryptoBar(bar))
+			})
+		},
+		params.Symbols...,
+	)
+}
+
+// SubscribeToUpdatedCryptoBars subscribes to minute bars that are corrected by late trades after they were sent.
+// This is a non-blocking call. The shared connection outlives ctx and is only closed by Close.
+func (cc *CryptoClient) SubscribeToUpdatedCryptoBars(ctx context.Context, params model.StreamCryptoUpdatesParams, handle CryptoBarHandler) error {
+	if err := cc.connect(ctx); err != nil {
+		return err
+	}
+	return cc.stream.SubscribeToUpdatedBars(
+		func(bar stream.CryptoBar) {
+			cc.handle(ctx, "updated crypto bar", func(ctx context.Context) error {
+				return handle(ctx, newCryptoBar(bar))
+			})
+		},
+		params.Symbols...,
+	)
+}
+
+// SubscribeToDailyCryptoBars subscribes to the running daily bars of the specified symbols.
+// This is a non-blocking call. The shared connection outlives ctx and is only closed by Close.
+func (cc *CryptoClient) SubscribeToDailyCryptoBars(ctx context.Context, params model.StreamCryptoUpdatesParams, handle CryptoBarHandler) error {
+	if err := cc.connect(ctx); err != nil {
+		return err
+	}
+	return cc.stream.SubscribeToDailyBars(
+		func(bar stream.CryptoBar) {
+			cc.handle(ctx, "daily crypto bar", func(ctx context.Context) error {
+				return handle(ctx, newCryptoBar(bar))
+			})
+		},
+		params.Symbols...,
+	)
+}
+
+// SubscribeToCryptoQuotes subscribes to the quotes of the specified symbols.
+// This is a non-blocking call. The shared connection outlives ctx and is only closed by Close.
+func (cc *CryptoClient) SubscribeToCryptoQuotes(ctx context.Context, params model.StreamCryptoUpdatesParams, handle CryptoQuoteHandler) error {
+	if err := cc.connect(ctx); err != nil {
+		return err
+	}
+	return cc.stream.SubscribeToQuotes(
+		func(quote stream.CryptoQuote) {
+			cc.handle(ctx, "crypto quote", func(ctx context.Context) error {
+				return handle(ctx, newCryptoQuote(quote))
+			})
+		},
+		params.Symbols...,
+	)
+}
+
+// SubscribeToCryptoTrades subscribes to the trades of the specified symbols.
+// This is a non-blocking call. The shared connection outlives ctx and is only closed by Close.
+func (cc *CryptoClient) SubscribeToCryptoTrades(ctx context.Context, params model.StreamCryptoUpdatesParams, handle CryptoTradeHandler) error {
+	if err := cc.connect(ctx); err != nil {
+		return err
+	}
+	return cc.stream.SubscribeToTrades(
+		func(trade stream.CryptoTrade) {
+			cc.handle(ctx, "crypto trade", func(ctx context.Context) error {
+				return handle(ctx, newCryptoTrade(trade))
+			})
+		},
+		params.Symbols...,
+	)
+}
+
+// SubscribeToOrderbooks subscribes to the orderbooks of the specified symbols.
+// The first orderbook of a symbol has Reset set and carries the whole book; the following ones only carry
+// the changed price levels, where a zero size removes the level.
+// This is a non-blocking call. The shared connection outlives ctx and is only closed by Close.
+func (cc *CryptoClient) SubscribeToOrderbooks(ctx context.Context, params model.StreamCryptoUpdatesParams, handle OrderbookHandler) error {
+	if err := cc.connect(ctx); err != nil {
+		return err
+	}
+	return cc.stream.SubscribeToOrderbooks(
+		func(orderbook stream.CryptoOrderbook) {
+			cc.handle(ctx, "orderbook", func(ctx context.Context) error {
+				return handle(ctx, newOrderbook(orderbook))
+			})
+		},
+		params.Symbols...,
+	)
+}
+
+// connect connects to the stream unless it is already connected, so that subscriptions can share the connection.
+// The connection lives until Close is called or the stream terminates, whatever the context of the caller.
+func (cc *CryptoClient) connect(context.Context) error {
+	return cc.connection.connect(cc.stream.Connect, cc.stream.Terminated)
+}
+
+// Close closes the crypto stream connection. The subscriptions stop receiving events,
+// and subscribing afterwards returns ErrStreamClosed.
+func (cc *CryptoClient) Close() {
+	cc.connection.close()
+}
+
+func (cc *CryptoClient) handle(ctx context.Context, kind string, handle func(context.Context) error) {
+	if err := handle(ctx); err != nil {
+		cc.logger.Error("handling "+kind, slog.Any("error", err))
+	}
+}
+
+func cryptoLocation(loc string) string {
+	if loc == "" {
+		return model.CryptoLocationUS
+	}
+	return loc
+}
+
+func newCryptoBar(bar stream.CryptoBar) *model.CryptoBar {
+	return &model.CryptoBar{
+		Symbol:                     bar.Symbol,
+		Open:                       decimal.NewFromFloat(bar.Open),
+		High:                       decimal.NewFromFloat(bar.High),
+		Low:                        decimal.NewFromFloat(bar.Low),
+		Close:                      decimal.NewFromFloat(bar.Close),
+		Volume:                     decimal.NewFromFloat(bar.Volume),
+		VolumeWeightedAveragePrice: decimal.NewFromFloat(bar.VWAP),
+		TradeCount:                 bar.TradeCount,
+		Timestamp:                  bar.Timestamp,
+	}
+}
+
+func newCryptoQuote(quote stream.CryptoQuote) *model.SymbolCryptoQuote {
+	return &model.SymbolCryptoQuote{
+		Symbol: quote.Symbol,
+		CryptoQuote: model.CryptoQuote{
+			AskPrice:  decimal.NewFromFloat(quote.AskPrice),
+			AskSize:   decimal.NewFromFloat(quote.AskSize),
+			BidPrice:  decimal.NewFromFloat(quote.BidPrice),
+			BidSize:   decimal.NewFromFloat(quote.BidSize),
+			Timestamp: quote.Timestamp,
+		},
+	}
+}
+
+func newCryptoTrade(trade stream.CryptoTrade) *model.SymbolCryptoTrade {
+	return &model.SymbolCryptoTrade{
+		Symbol: trade.Symbol,
+		CryptoTrade: model.CryptoTrade{
+			ID:        trade.ID,
+			Price:     decimal.NewFromFloat(trade.Price),
+			Size:      decimal.NewFromFloat(trade.Size),
+			TakerSide: model.TakerSide(trade.TakerSide),
+			Timestamp: trade.Timestamp,
+		},
+	}
+}
+
+func newOrderbook(orderbook stream.CryptoOrderbook) *model.Orderbook {
+	return &model.Orderbook{
+		Symbol:    orderbook.Symbol,
+		Bids:      newOrderbookEntries(orderbook.Bids),
+		Asks:      newOrderbookEntries(orderbook.Asks),
+		Timestamp: orderbook.Timestamp,
+		Reset:     orderbook.Reset,
+	}
+}
+
+func newOrderbookEntries(entries []stream.CryptoOrderbookEntry) []model.OrderbookEntry {
+	res := make([]model.OrderbookEntry, len(entries))
+	for i, e := range entries {
+		res[i] = model.OrderbookEntry{
+			Price: decimal.NewFromFloat(e.Price),
+			Size:  decimal.NewFromFloat(e.Size),
+		}
+	}
+	return res
+}
@@ -2,6 +2,7 @@ package market
 
 import (
 	"context"
+	"iter"
 	"net/http"
 
 	"go.tradeforge.dev/alpaca/client"
@@ -21,3 +22,25 @@ func (nc *NewsClient) GetLatestNews(ctx context.Context, params model.GetNewsPar
 	err := nc.Call(ctx, http.MethodGet, GetNewsPath, params, res, opts...)
 	return res, err
 }
+
+// IterNews returns an iterator over the news matching the params, following the page tokens.
+// Pages are fetched lazily; use model.WithPrefetch to fetch the next page in advance.
+func (nc *NewsClient) IterNews(ctx context.Context, params model.GetNewsParams, opts ...model.RequestOption) iter.Seq2[model.News, error] {
+	return client.Paginate(
+		ctx,
+		params.PageToken,
+		func(ctx context.Context, pageToken *string) (client.Page[model.News, *string], error) {
+			params.PageToken = pageToken
+			res, err := nc.GetLatestNews(ctx, params, opts...)
+			if err != nil {
+				return client.Page[model.News, *string]{}, err
+			}
+			return client.Page[model.News, *string]{
+				Items:   res.News,
+				Next:    res.NextPageToken,
+				HasNext: res.NextPageToken != nil && *res.NextPageToken != "",
+			}, nil
+		},
+		opts...,
+	)
+}
@@ -0,0 +1,132 @@
+package market
+
+import (
+	"context"
+	"iter"
+	"maps"
+	"net/http"
+	"slices"
+
+	"go.tradeforge.dev/alpaca/client"
+	"go.tradeforge.dev/alpaca/model"
+)
+
+const (
+	GetOptionChainPath        = "/v1beta1/options/snapshots/:underlying_symbol"
+	GetOptionBarsPath         = "/v1beta1/options/bars"
+	GetOptionTradesPath       = "/v1beta1/options/trades"
+	GetLatestOptionQuotesPath = "/v1beta1/options/latest/quotes"
+	GetLatestOptionTradesPath = "/v1beta1/options/latest/trades"
+)
+
+// OptionsClient is a client for the options API.
+type OptionsClient struct {
+	*client.Client
+}
+
+// GetOptionChain returns the snapshots, with greeks and implied volatility, of the option contracts of an underlying.
+func (oc *OptionsClient) GetOptionChain(ctx context.Context, params model.GetOptionChainParams, opts ...model.RequestOption) (*model.GetOptionChainResponse, error) {
+	res := &model.GetOptionChainResponse{}
+	err := oc.Call(ctx, http.MethodGet, GetOptionChainPath, params, res, opts...)
+	return res, err
+}
+
+// GetFullOptionChain returns the snapshots of all the option contracts of an underlying matching the params,
+// following the page tokens.
+func (oc *OptionsClient) GetFullOptionChain(ctx context.Context, params model.GetOptionChainParams, opts ...model.RequestOption) (model.OptionChain, error) {
+	chain := model.OptionChain{}
+	for {
+		res, err := oc.GetOptionChain(ctx, params, opts...)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(chain, res.Snapshots)
+		if res.NextPageToken == "" {
+			return chain, nil
+		}
+		params.PageToken = &res.NextPageToken
+	}
+}
+
+func (oc *OptionsClient) GetOptionBars(ctx context.Context, params model.GetOptionBarsParams, opts ...model.RequestOption) (*model.GetOptionBarsResponse, error) {
+	res := &model.GetOptionBarsResponse{}
+	err := oc.Call(ctx, http.MethodGet, GetOptionBarsPath, params, res, opts...)
+	return res, err
+}
+
+// IterOptionBars returns an iterator over the option bars matching the params, following the page tokens.
+// Within a page, bars are yielded symbol by symbol in alphabetical order, with their Symbol field set.
+// Pages are fetched lazily; use model.WithPrefetch to fetch the next page in advance.
+func (oc *OptionsClient) IterOptionBars(ctx context.Context, params model.GetOptionBarsParams, opts ...model.RequestOption) iter.Seq2[model.Bar, error] {
+	return client.Paginate(
+		ctx,
+		params.PageToken,
+		func(ctx context.Context, pageToken *string) (client.Page[model.Bar, *string], error) {
+			params.PageToken = pageToken
+			res, err := oc.GetOptionBars(ctx, params, opts...)
+			if err != nil {
+				return client.Page[model.Bar, *string]{}, err
+			}
+			var bars []model.Bar
+			for _, symbol := range slices.Sorted(maps.Keys(res.Bars)) {
+				for _, bar := range res.Bars[symbol] {
+					bar.Symbol = symbol
+					bars = append(bars, bar)
+				}
+			}
+			return client.Page[model.Bar, *string]{
+				Items:   bars,
+				Next:    &res.NextPageToken,
+				HasNext: res.NextPageToken != "",
+			}, nil
+		},
+		opts...,
+	)
+}
+
+func (oc *OptionsClient) GetOptionTrades(ctx context.Context, params model.GetOptionTradesParams, opts ...model.RequestOption) (*model.GetOptionTradesResponse, error) {
+	res := &model.GetOptionTradesResponse{}
+	err := oc.Call(ctx, http.MethodGet, GetOptionTradesPath, params, res, opts...)
+	return res, err
+}
+
+// IterOptionTrades returns an iterator over the option trades matching the params, following the page tokens.
+// Within a page, trades are yielded symbol by symbol in alphabetical order.
+// Pages are fetched lazily; use model.WithPrefetch to fetch the next page in advance.
+func (oc *OptionsClient) IterOptionTrades(ctx context.Context, params model.GetOptionTradesParams, opts ...model.RequestOption) iter.Seq2[model.SymbolOptionTrade, error] {
+	return client.Paginate(
+		ctx,
+		params.PageToken,
+		func(ctx context.Context, pageToken *string) (client.Page[model.SymbolOptionTrade, *string], error) {
+			params.PageToken = pageToken
+			res, err := oc.GetOptionTrades(ctx, params, opts...)
+			if err != nil {
+				return client.Page[model.SymbolOptionTrade, *string]{}, err
+			}
+			var trades []model.SymbolOptionTrade
+			for _, symbol := range slices.Sorted(maps.Keys(res.Trades)) {
+				for _, trade := range res.Trades[symbol] {
+					trades = append(trades, model.SymbolOptionTrade{Symbol: symbol, OptionTrade: trade})
+				}
+			}
+			return client.Page[model.SymbolOptionTrade, *string]{
+				Items:   trades,
+				Next:    &res.NextPageToken,
+				HasNext: res.NextPageToken != "",
+			}, nil
+		},
+		opts...,
+	)
+}
+
+func (oc *OptionsClient) GetLatestOptionQuotes(ctx context.Context, params model.GetLatestOptionQuotesParams, opts ...model.RequestOption) (*model.GetLatestOptionQuotesResponse, error) {
+	res := &model.GetLatestOptionQuotesResponse{}
+	err := oc.Call(ctx, http.MethodGet, GetLatestOptionQuotesPath, params, res, opts...)
+	return res, err
+}
+
+func (oc *OptionsClient) GetLatestOptionTrades(ctx context.Context, params model.GetLatestOptionTradesParams, opts ...model.RequestOption) (*model.GetLatestOptionTradesResponse, error) {
+	res := &model.GetLatestOptionTradesResponse{}
+	err := oc.Call(ctx, http.MethodGet, GetLatestOptionTradesPath, params, res, opts...)
+	return res, err
+}
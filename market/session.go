@@ -0,0 +1,454 @@
+package market
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/alpacahq/alpaca-trade-api-go/v3/marketdata/stream"
+)
+
+// StreamChannel identifies a kind of stock stream data.
+type StreamChannel string
+
+const (
+	StreamChannelBars        StreamChannel = "bars"
+	StreamChannelUpdatedBars StreamChannel = "updated bars"
+	StreamChannelDailyBars   StreamChannel = "daily bars"
+	StreamChannelQuotes      StreamChannel = "quotes"
+	StreamChannelTrades      StreamChannel = "trades"
+	StreamChannelStatuses    StreamChannel = "statuses"
+	StreamChannelLULDs       StreamChannel = "LULDs"
+)
+
+// ErrStreamChannelInUse is returned when a stream session is created for a channel used by another session.
+var ErrStreamChannelInUse = errors.New("stream channel used by another session")
+
+// FailureAction is what a stream session does when a handler keeps failing.
+type FailureAction int
+
+const (
+	// FailureActionLog only logs the handler errors.
+	FailureActionLog FailureAction = iota
+	// FailureActionUnsubscribe unsubscribes the channel of the failing handler for all the symbols of the session.
+	FailureActionUnsubscribe
+	// FailureActionTerminate ends the session with the handler error.
+	FailureActionTerminate
+)
+
+// FailurePolicy defines when and how a stream session reacts to a failing handler.
+type FailurePolicy struct {
+	// MaxConsecutiveFailures is the number of consecutive handler errors that triggers the action.
+	// The count is reset whenever the handler succeeds. Zero disables the action.
+	MaxConsecutiveFailures int
+	Action                 FailureAction
+}
+
+// StreamSessionOptions define the handlers of a stream session. Channels without a handler are not subscribed.
+type StreamSessionOptions struct {
+	Bars        StockBarUpdateHandler
+	UpdatedBars StockBarUpdateHandler
+	DailyBars   StockBarUpdateHandler
+	Quotes      StockQuoteHandler
+	Trades      StockTradeHandler
+	Statuses    TradingStatusHandler
+	LULDs       LULDHandler
+
+	// FailurePolicy applies to every handler. Each handler counts its own failures.
+	FailurePolicy FailurePolicy
+	// FailurePolicies override FailurePolicy for specific channels.
+	FailurePolicies map[StreamChannel]FailurePolicy
+}
+
+// StreamSession is a set of symbols subscribed on the shared stock stream connection.
+// The subscribed symbols can be changed while the session is running. The stream resubscribes
+// to the current set of symbols when it reconnects.
+type StreamSession struct {
+	owner   *StocksClient
+	stream  *stream.StocksClient
+	logger  *slog.Logger
+	options StreamSessionOptions
+
+	// changeMu serializes subscription changes. It is never held by handlers, so that the stream can
+	// keep delivering events while a change waits to be acknowledged.
+	changeMu sync.Mutex
+	// mu guards the state below. It is never held while waiting for the stream.
+	mu       sync.Mutex
+	symbols  map[string]struct{}
+	channels map[StreamChannel]*sessionChannel
+	err      error
+	done     chan struct{}
+}
+
+type sessionChannel struct {
+	subscribe   func(symbols ...string) error
+	unsubscribe func(symbols ...string) error
+	policy      FailurePolicy
+	failures    int
+}
+
+// NewStreamSession connects to the stock stream, unless it is already connected, and returns a session
+// without symbols. The session is terminated with the context error once ctx is done.
+// The connection is owned by the client rather than by the session: it outlives ctx and is only closed
+// by StocksClient.Close, so that ending one session does not end the others.
+//
+// The connection is shared and the stream has a single handler per channel, so a channel can only be used
+// by one running session: ErrStreamChannelInUse is returned if another session uses one of the channels.
+// The handlers still replace those of the subscriptions made with the SubscribeTo methods.
+func (sc *StocksClient) NewStreamSession(ctx context.Context, options StreamSessionOptions) (*StreamSession, error) {
+	if err := sc.connect(ctx); err != nil {
+		return nil, err
+	}
+	s := &StreamSession{
+		owner:    sc,
+		stream:   sc.stream,
+		logger:   sc.logger,
+		options:  options,
+		symbols:  map[string]struct{}{},
+		channels: map[StreamChannel]*sessionChannel{},
+		done:     make(chan struct{}),
+	}
+	s.register(ctx)
+	if err := sc.claimChannels(s); err != nil {
+		return nil, err
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+			if err := s.terminate(ctx.Err()); err != nil {
+				s.logger.Error("terminating stream session", slog.Any("error", err))
+			}
+		case <-s.done:
+		}
+	}()
+	return s, nil
+}
+
+// register sets up the channels that have a handler.
+func (s *StreamSession) register(ctx context.Context) {
+	o := s.options
+	if o.Bars != nil {
+		s.addChannel(StreamChannelBars, func(symbols ...string) error {
+			return s.stream.SubscribeToBars(func(bar stream.Bar) {
+				s.handle(ctx, StreamChannelBars, func(ctx context.Context) error { return o.Bars(ctx, newBar(bar)) })
+			}, symbols...)
+		}, s.stream.UnsubscribeFromBars)
+	}
+	if o.UpdatedBars != nil {
+		s.addChannel(StreamChannelUpdatedBars, func(symbols ...string) error {
+			return s.stream.SubscribeToUpdatedBars(func(bar stream.Bar) {
+				s.handle(ctx, StreamChannelUpdatedBars, func(ctx context.Context) error { return o.UpdatedBars(ctx, newBar(bar)) })
+			}, symbols...)
+		}, s.stream.UnsubscribeFromUpdatedBars)
+	}
+	if o.DailyBars != nil {
+		s.addChannel(StreamChannelDailyBars, func(symbols ...string) error {
+			return s.stream.SubscribeToDailyBars(func(bar stream.Bar) {
+				s.handle(ctx, StreamChannelDailyBars, func(ctx context.Context) error { return o.DailyBars(ctx, newBar(bar)) })
+			}, symbols...)
+		}, s.stream.UnsubscribeFromDailyBars)
+	}
+	if o.Quotes != nil {
+		s.addChannel(StreamChannelQuotes, func(symbols ...string) error {
+			return s.stream.SubscribeToQuotes(func(quote stream.Quote) {
+				s.handle(ctx, StreamChannelQuotes, func(ctx context.Context) error { return o.Quotes(ctx, newQuote(quote)) })
+			}, symbols...)
+		}, s.stream.UnsubscribeFromQuotes)
+	}
+	if o.Trades != nil {
+		s.addChannel(StreamChannelTrades, func(symbols ...string) error {
+			return s.stream.SubscribeToTrades(func(trade stream.Trade) {
+				s.handle(ctx, StreamChannelTrades, func(ctx context.Context) error { return o.Trades(ctx, newTrade(trade)) })
+			}, symbols...)
+		}, s.stream.UnsubscribeFromTrades)
+	}
+	if o.Statuses != nil {
+		s.addChannel(StreamChannelStatuses, func(symbols ...string) error {
+			return s.stream.SubscribeToStatuses(func(status stream.TradingStatus) {
+				s.handle(ctx, StreamChannelStatuses, func(ctx context.Context) error { return o.Statuses(ctx, newTradingStatus(status)) })
+			}, symbols...)
+		}, s.stream.UnsubscribeFromStatuses)
+	}
+	if o.LULDs != nil {
+		s.addChannel(StreamChannelLULDs, func(symbols ...string) error {
+			return s.stream.SubscribeToLULDs(func(luld stream.LULD) {
+				s.handle(ctx, StreamChannelLULDs, func(ctx context.Context) error { return o.LULDs(ctx, newLULD(luld)) })
+			}, symbols...)
+		}, s.stream.UnsubscribeFromLULDs)
+	}
+}
+
+func (s *StreamSession) addChannel(channel StreamChannel, subscribe, unsubscribe func(symbols ...string) error) {
+	policy, ok := s.options.FailurePolicies[channel]
+	if !ok {
+		policy = s.options.FailurePolicy
+	}
+	s.channels[channel] = &sessionChannel{
+		subscribe:   subscribe,
+		unsubscribe: unsubscribe,
+		policy:      policy,
+	}
+}
+
+// Add subscribes the symbols on every channel of the session. Symbols that are already subscribed are ignored.
+//
+// Add waits for the stream to acknowledge the change, and the acknowledgement is read by the goroutine that
+// runs the handlers. Add must therefore not be called from a stream handler, where it would deadlock:
+// start a goroutine from the handler instead.
+func (s *StreamSession) Add(symbols ...string) error {
+	s.changeMu.Lock()
+	defer s.changeMu.Unlock()
+
+	s.mu.Lock()
+	if s.isDone() {
+		defer s.mu.Unlock()
+		return s.terminatedError()
+	}
+	added := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		if _, ok := s.symbols[symbol]; !ok && !slices.Contains(added, symbol) {
+			added = append(added, symbol)
+		}
+	}
+	channels := maps.Clone(s.channels)
+	s.mu.Unlock()
+
+	if len(added) == 0 {
+		return nil
+	}
+	subscribed := make(map[StreamChannel]*sessionChannel, len(channels))
+	for channel, c := range channels {
+		if err := c.subscribe(added...); err != nil {
+			// The symbols are not recorded, so they are unsubscribed from the channels that were already done.
+			errs := []error{fmt.Errorf("subscribing to %s: %w", channel, err)}
+			for channel, c := range subscribed {
+				if err := c.unsubscribe(added...); err != nil {
+					errs = append(errs, fmt.Errorf("unsubscribing from %s: %w", channel, err))
+				}
+			}
+			return errors.Join(errs...)
+		}
+		subscribed[channel] = c
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, symbol := range added {
+		s.symbols[symbol] = struct{}{}
+	}
+	return nil
+}
+
+// Remove unsubscribes the symbols from every channel of the session. Symbols that are not subscribed are ignored.
+// Like Add, Remove waits for the stream to acknowledge the change and must not be called from a stream handler.
+func (s *StreamSession) Remove(symbols ...string) error {
+	s.changeMu.Lock()
+	defer s.changeMu.Unlock()
+
+	s.mu.Lock()
+	if s.isDone() {
+		defer s.mu.Unlock()
+		return s.terminatedError()
+	}
+	removed := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		if _, ok := s.symbols[symbol]; ok && !slices.Contains(removed, symbol) {
+			removed = append(removed, symbol)
+		}
+	}
+	channels := maps.Clone(s.channels)
+	s.mu.Unlock()
+
+	if len(removed) == 0 {
+		return nil
+	}
+	for channel, c := range channels {
+		if err := c.unsubscribe(removed...); err != nil {
+			return fmt.Errorf("unsubscribing from %s: %w", channel, err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, symbol := range removed {
+		delete(s.symbols, symbol)
+	}
+	return nil
+}
+
+// Symbols returns the subscribed symbols in alphabetical order.
+func (s *StreamSession) Symbols() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Sorted(maps.Keys(s.symbols))
+}
+
+// Channels returns the channels that are still subscribed.
+func (s *StreamSession) Channels() []StreamChannel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Sorted(maps.Keys(s.channels))
+}
+
+// Close unsubscribes all the symbols of the session. It is idempotent.
+// Like Add, Close must not be called from a stream handler.
+func (s *StreamSession) Close() error {
+	return s.terminate(nil)
+}
+
+// Done returns a channel that is closed when the session has ended.
+func (s *StreamSession) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns the handler or context error that terminated the session, or nil if it is running or was closed.
+func (s *StreamSession) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+func (s *StreamSession) handle(ctx context.Context, channel StreamChannel, handle func(context.Context) error) {
+	err := handle(ctx)
+
+	s.mu.Lock()
+	c, ok := s.channels[channel]
+	if !ok || s.isDone() {
+		// The channel was dropped while the event was being handled.
+		s.mu.Unlock()
+		return
+	}
+	if err == nil {
+		c.failures = 0
+		s.mu.Unlock()
+		return
+	}
+	c.failures++
+	trigger := c.policy.MaxConsecutiveFailures > 0 && c.failures >= c.policy.MaxConsecutiveFailures
+	s.mu.Unlock()
+
+	s.logger.Error("handling "+string(channel), slog.Any("error", err))
+	if !trigger {
+		return
+	}
+
+	// Subscription changes wait for the stream to acknowledge them, which cannot happen while
+	// the stream is blocked in a handler, so the action is applied in the background.
+	switch c.policy.Action {
+	case FailureActionUnsubscribe:
+		go func() {
+			if err := s.dropChannel(channel); err != nil {
+				s.logger.Error("unsubscribing failing handler", slog.String("channel", string(channel)), slog.Any("error", err))
+			}
+		}()
+	case FailureActionTerminate:
+		go func() {
+			if err := s.terminate(fmt.Errorf("handling %s: %w", channel, err)); err != nil {
+				s.logger.Error("terminating stream session", slog.Any("error", err))
+			}
+		}()
+	}
+}
+
+// dropChannel unsubscribes a channel for all the symbols of the session.
+func (s *StreamSession) dropChannel(channel StreamChannel) error {
+	s.changeMu.Lock()
+	defer s.changeMu.Unlock()
+
+	s.mu.Lock()
+	c, ok := s.channels[channel]
+	if !ok {
+		s.mu.Unlock()
+		return nil
+	}
+	delete(s.channels, channel)
+	symbols := slices.Collect(maps.Keys(s.symbols))
+	s.mu.Unlock()
+
+	defer s.owner.releaseChannels(s, channel)
+	if len(symbols) == 0 {
+		return nil
+	}
+	s.logger.Warn("unsubscribing failing handler", slog.String("channel", string(channel)))
+	return c.unsubscribe(symbols...)
+}
+
+// terminate unsubscribes all the symbols from all the channels and ends the session with the given error.
+func (s *StreamSession) terminate(cause error) error {
+	s.changeMu.Lock()
+	defer s.changeMu.Unlock()
+
+	s.mu.Lock()
+	if s.isDone() {
+		s.mu.Unlock()
+		return nil
+	}
+	symbols := slices.Collect(maps.Keys(s.symbols))
+	channels := s.channels
+	s.channels = map[StreamChannel]*sessionChannel{}
+	s.symbols = map[string]struct{}{}
+	s.err = cause
+	close(s.done)
+	s.mu.Unlock()
+
+	// The channels are released once unsubscribed, so that a new session cannot lose its symbols.
+	defer s.owner.releaseChannels(s, slices.Collect(maps.Keys(channels))...)
+	var errs []error
+	if len(symbols) > 0 {
+		for channel, c := range channels {
+			if err := c.unsubscribe(symbols...); err != nil {
+				errs = append(errs, fmt.Errorf("unsubscribing from %s: %w", channel, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// claimChannels reserves the channels of a new session.
+func (sc *StocksClient) claimChannels(s *StreamSession) error {
+	sc.sessionsMu.Lock()
+	defer sc.sessionsMu.Unlock()
+	for channel := range s.channels {
+		if _, ok := sc.sessions[channel]; ok {
+			return fmt.Errorf("%w: %s", ErrStreamChannelInUse, channel)
+		}
+	}
+	if sc.sessions == nil {
+		sc.sessions = map[StreamChannel]*StreamSession{}
+	}
+	for channel := range s.channels {
+		sc.sessions[channel] = s
+	}
+	return nil
+}
+
+// releaseChannels frees the channels of a session for new sessions.
+func (sc *StocksClient) releaseChannels(s *StreamSession, channels ...StreamChannel) {
+	sc.sessionsMu.Lock()
+	defer sc.sessionsMu.Unlock()
+	for _, channel := range channels {
+		if sc.sessions[channel] == s {
+			delete(sc.sessions, channel)
+		}
+	}
+}
+
+func (s *StreamSession) isDone() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *StreamSession) terminatedError() error {
+	if s.err != nil {
+		return fmt.Errorf("stream session terminated: %w", s.err)
+	}
+	return errors.New("stream session closed")
+}
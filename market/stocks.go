@@ -2,8 +2,14 @@ package market
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"iter"
 	"log/slog"
+	"maps"
 	"net/http"
+	"slices"
+	"sync"
 
 	"github.com/alpacahq/alpaca-trade-api-go/v3/marketdata/stream"
 	"github.com/shopspring/decimal"
@@ -13,16 +19,26 @@ import (
 )
 
 const (
-	GetLatestQuotesPath   = "/v2/stocks/quotes/latest"
-	GetSnapshotsPath      = "/v2/stocks/snapshots"
-	GetHistoricalBarsPath = "/v2/stocks/bars"
+	GetLatestQuotesPath     = "/v2/stocks/quotes/latest"
+	GetLatestTradesPath     = "/v2/stocks/trades/latest"
+	GetLatestBarsPath       = "/v2/stocks/bars/latest"
+	GetSnapshotsPath        = "/v2/stocks/snapshots"
+	GetHistoricalBarsPath   = "/v2/stocks/bars"
+	GetHistoricalTradesPath = "/v2/stocks/trades"
+	GetHistoricalQuotesPath = "/v2/stocks/quotes"
 )
 
 // StocksClient is a client for the stocks API.
+// The stream connection is shared by every subscription and session and owned by the client; close it with Close.
 type StocksClient struct {
 	*client.Client
-	stream *stream.StocksClient
-	logger *slog.Logger
+	stream     *stream.StocksClient
+	connection streamConnection
+	logger     *slog.Logger
+
+	// sessionsMu guards sessions, which holds the stream session using each channel.
+	sessionsMu sync.Mutex
+	sessions   map[StreamChannel]*StreamSession
 }
 
 func (sc *StocksClient) GetLatestQuotes(ctx context.Context, params model.GetLatestQuotesParams, opts ...model.RequestOption) (*model.GetLatestQuotesResponse, error) {
@@ -31,6 +47,18 @@ func (sc *StocksClient) GetLatestQuotes(ctx context.Context, params model.GetLat
 	return res, err
 }
 
+func (sc *StocksClient) GetLatestTrades(ctx context.Context, params model.GetLatestTradesParams, opts ...model.RequestOption) (*model.GetLatestTradesResponse, error) {
+	res := &model.GetLatestTradesResponse{}
+	err := sc.Call(ctx, http.MethodGet, GetLatestTradesPath, params, res, opts...)
+	return res, err
+}
+
+func (sc *StocksClient) GetLatestBars(ctx context.Context, params model.GetLatestBarsParams, opts ...model.RequestOption) (*model.GetLatestBarsResponse, error) {
+	res := &model.GetLatestBarsResponse{}
+	err := sc.Call(ctx, http.MethodGet, GetLatestBarsPath, params, res, opts...)
+	return res, err
+}
+
 func (sc *StocksClient) GetSnapshots(ctx context.Context, params model.GetSnapshotsParams, opts ...model.RequestOption) (*model.GetSnapshotsResponse, error) {
 	res := map[string]model.Snapshot{}
 	err := sc.Call(ctx, http.MethodGet, GetSnapshotsPath, params, &res, opts...)
@@ -43,35 +71,395 @@ func (sc *StocksClient) GetHistoricalBars(ctx context.Context, params model.GetH
 	return res, err
 }
 
+// IterHistoricalBars returns an iterator over the historical bars matching the params, following the page tokens.
+// Within a page, bars are yielded symbol by symbol in alphabetical order, with their Symbol field set.
+// Pages are fetched lazily; use model.WithPrefetch to fetch the next page in advance.
+func (sc *StocksClient) IterHistoricalBars(ctx context.Context, params model.GetHistoricalBarsParams, opts ...model.RequestOption) iter.Seq2[model.Bar, error] {
+	return client.Paginate(
+		ctx,
+		params.PageToken,
+		func(ctx context.Context, pageToken *string) (client.Page[model.Bar, *string], error) {
+			params.PageToken = pageToken
+			res, err := sc.GetHistoricalBars(ctx, params, opts...)
+			if err != nil {
+				return client.Page[model.Bar, *string]{}, err
+			}
+			var bars []model.Bar
+			for _, symbol := range slices.Sorted(maps.Keys(res.Bars)) {
+				for _, bar := range res.Bars[symbol] {
+					bar.Symbol = symbol
+					bars = append(bars, bar)
+				}
+			}
+			return client.Page[model.Bar, *string]{
+				Items:   bars,
+				Next:    &res.NextPageToken,
+				HasNext: res.NextPageToken != "",
+			}, nil
+		},
+		opts...,
+	)
+}
+
+func (sc *StocksClient) GetHistoricalTrades(ctx context.Context, params model.GetHistoricalTradesParams, opts ...model.RequestOption) (*model.GetHistoricalTradesResponse, error) {
+	res := &model.GetHistoricalTradesResponse{}
+	err := sc.Call(ctx, http.MethodGet, GetHistoricalTradesPath, params, res, opts...)
+	return res, err
+}
+
+// IterHistoricalTrades returns an iterator over the historical trades matching the params, following the page tokens.
+// Within a page, trades are yielded symbol by symbol in alphabetical order.
+// Pages are fetched lazily; use model.WithPrefetch to fetch the next page in advance.
+func (sc *StocksClient) IterHistoricalTrades(ctx context.Context, params model.GetHistoricalTradesParams, opts ...model.RequestOption) iter.Seq2[model.SymbolTrade, error] {
+	return client.Paginate(
+		ctx,
+		params.PageToken,
+		func(ctx context.Context, pageToken *string) (client.Page[model.SymbolTrade, *string], error) {
+			params.PageToken = pageToken
+			res, err := sc.GetHistoricalTrades(ctx, params, opts...)
+			if err != nil {
+				return client.Page[model.SymbolTrade, *string]{}, err
+			}
+			var trades []model.SymbolTrade
+			for _, symbol := range slices.Sorted(maps.Keys(res.Trades)) {
+				for _, trade := range res.Trades[symbol] {
+					trades = append(trades, model.SymbolTrade{Symbol: symbol, Trade: trade})
+				}
+			}
+			return client.Page[model.SymbolTrade, *string]{
+				Items:   trades,
+				Next:    &res.NextPageToken,
+				HasNext: res.NextPageToken != "",
+			}, nil
+		},
+		opts...,
+	)
+}
+
+func (sc *StocksClient) GetHistoricalQuotes(ctx context.Context, params model.GetHistoricalQuotesParams, opts ...model.RequestOption) (*model.GetHistoricalQuotesResponse, error) {
+	res := &model.GetHistoricalQuotesResponse{}
+	err := sc.Call(ctx, http.MethodGet, GetHistoricalQuotesPath, params, res, opts...)
+	return res, err
+}
+
+// IterHistoricalQuotes returns an iterator over the historical quotes matching the params, following the page tokens.
+// Within a page, quotes are yielded symbol by symbol in alphabetical order.
+// Pages are fetched lazily; use model.WithPrefetch to fetch the next page in advance.
+func (sc *StocksClient) IterHistoricalQuotes(ctx context.Context, params model.GetHistoricalQuotesParams, opts ...model.RequestOption) iter.Seq2[model.SymbolQuote, error] {
+	return client.Paginate(
+		ctx,
+		params.PageToken,
+		func(ctx context.Context, pageToken *string) (client.Page[model.SymbolQuote, *string], error) {
+			params.PageToken = pageToken
+			res, err := sc.GetHistoricalQuotes(ctx, params, opts...)
+			if err != nil {
+				return client.Page[model.SymbolQuote, *string]{}, err
+			}
+			var quotes []model.SymbolQuote
+			for _, symbol := range slices.Sorted(maps.Keys(res.Quotes)) {
+				for _, quote := range res.Quotes[symbol] {
+					quotes = append(quotes, model.SymbolQuote{Symbol: symbol, Quote: quote})
+				}
+			}
+			return client.Page[model.SymbolQuote, *string]{
+				Items:   quotes,
+				Next:    &res.NextPageToken,
+				HasNext: res.NextPageToken != "",
+			}, nil
+		},
+		opts...,
+	)
+}
+
 type StockBarUpdateHandler func(context.Context, *model.Bar) error
 
-// SubscribeToBarsEvents subscribes to bar updates for the specified symbols.
+type StockQuoteHandler func(context.Context, *model.SymbolQuote) error
+
+type StockTradeHandler func(context.Context, *model.SymbolTrade) error
+
+type TradingStatusHandler func(context.Context, *model.TradingStatus) error
+
+type LULDHandler func(context.Context, *model.LULD) error
+
+// SubscribeToBarsEvents subscribes to minute bars for the specified symbols.
 // The handler is called for each bar update.
-// This is a non-blocking call.
+// This is a non-blocking call. The shared connection outlives ctx and is only closed by Close.
 func (sc *StocksClient) SubscribeToBarsEvents(
 	ctx context.Context,
 	params model.StreamStockUpdatesParams,
 	handle StockBarUpdateHandler,
 ) error {
-	if err := sc.stream.Connect(ctx); err != nil {
+	if err := sc.connect(ctx); err != nil {
 		return err
 	}
 	return sc.stream.SubscribeToBars(
 		func(bar stream.Bar) {
-			if err := handle(ctx, &model.Bar{
-				Symbol:                     bar.Symbol,
-				Open:                       decimal.NewFromFloat(bar.Open),
-				High:                       decimal.NewFromFloat(bar.High),
-				Low:                        decimal.NewFromFloat(bar.Low),
-				Close:                      decimal.NewFromFloat(bar.Close),
-				Volume:                     bar.Volume,
-				VolumeWeightedAveragePrice: decimal.NewFromFloat(bar.VWAP),
-				Timestamp:                  bar.Timestamp,
-			}); err != nil {
-				// TODO: We might want to optionally unsubscribe from the stream here.
-				sc.logger.Error("handling bar", slog.Any("error", err))
-			}
+			sc.handle(ctx, "bar", func(ctx context.Context) error {
+				return handle(ctx, newBar(bar))
+			})
+		},
+		params.Symbols...,
+	)
+}
+
+// SubscribeToUpdatedBars subscribes to minute bars that are corrected by late trades after they were sent.
+// This is a non-blocking call. The shared connection outlives ctx and is only closed by Close.
+func (sc *StocksClient) SubscribeToUpdatedBars(ctx context.Context, params model.StreamStockUpdatesParams, handle StockBarUpdateHandler) error {
+	if err := sc.connect(ctx); err != nil {
+		return err
+	}
+	return sc.stream.SubscribeToUpdatedBars(
+		func(bar stream.Bar) {
+			sc.handle(ctx, "updated bar", func(ctx context.Context) error {
+				return handle(ctx, newBar(bar))
+			})
+		},
+		params.Symbols...,
+	)
+}
+
+// SubscribeToDailyBars subscribes to the running daily bars of the specified symbols.
+// This is a non-blocking call. The shared connection outlives ctx and is only closed by Close.
+func (sc *StocksClient) SubscribeToDailyBars(ctx context.Context, params model.StreamStockUpdatesParams, handle StockBarUpdateHandler) error {
+	if err := sc.connect(ctx); err != nil {
+		return err
+	}
+	return sc.stream.SubscribeToDailyBars(
+		func(bar stream.Bar) {
+			sc.handle(ctx, "daily bar", func(ctx context.Context) error {
+				return handle(ctx, newBar(bar))
+			})
 		},
 		params.Symbols...,
 	)
 }
+
+// SubscribeToQuotes subscribes to the quotes of the specified symbols.
+// This is a non-blocking call. The shared connection outlives ctx and is only closed by Close.
+func (sc *StocksClient) SubscribeToQuotes(ctx context.Context, params model.StreamStockUpdatesParams, handle StockQuoteHandler) error {
+	if err := sc.connect(ctx); err != nil {
+		return err
+	}
+	return sc.stream.SubscribeToQuotes(
+		func(quote stream.Quote) {
+			sc.handle(ctx, "quote", func(ctx context.Context) error {
+				return handle(ctx, newQuote(quote))
+			})
+		},
+		params.Symbols...,
+	)
+}
+
+// SubscribeToTrades subscribes to the trades of the specified symbols.
+// This is a non-blocking call. The shared connection outlives ctx and is only closed by Close.
+func (sc *StocksClient) SubscribeToTrades(ctx context.Context, params model.StreamStockUpdatesParams, handle StockTradeHandler) error {
+	if err := sc.connect(ctx); err != nil {
+		return err
+	}
+	return sc.stream.SubscribeToTrades(
+		func(trade stream.Trade) {
+			sc.handle(ctx, "trade", func(ctx context.Context) error {
+				return handle(ctx, newTrade(trade))
+			})
+		},
+		params.Symbols...,
+	)
+}
+
+// SubscribeToStatuses subscribes to the trading statuses, such as halts, of the specified symbols.
+// This is a non-blocking call. The shared connection outlives ctx and is only closed by Close.
+func (sc *StocksClient) SubscribeToStatuses(ctx context.Context, params model.StreamStockUpdatesParams, handle TradingStatusHandler) error {
+	if err := sc.connect(ctx); err != nil {
+		return err
+	}
+	return sc.stream.SubscribeToStatuses(
+		func(status stream.TradingStatus) {
+			sc.handle(ctx, "trading status", func(ctx context.Context) error {
+				return handle(ctx, newTradingStatus(status))
+			})
+		},
+		params.Symbols...,
+	)
+}
+
+// SubscribeToLULDs subscribes to the limit up-limit down bands of the specified symbols.
+// This is a non-blocking call. The shared connection outlives ctx and is only closed by Close.
+func (sc *StocksClient) SubscribeToLULDs(ctx context.Context, params model.StreamStockUpdatesParams, handle LULDHandler) error {
+	if err := sc.connect(ctx); err != nil {
+		return err
+	}
+	return sc.stream.SubscribeToLULDs(
+		func(luld stream.LULD) {
+			sc.handle(ctx, "LULD", func(ctx context.Context) error {
+				return handle(ctx, newLULD(luld))
+			})
+		},
+		params.Symbols...,
+	)
+}
+
+// connect connects to the stream unless it is already connected, so that subscriptions can share the connection.
+// The connection lives until Close is called or the stream terminates, whatever the context of the caller.
+func (sc *StocksClient) connect(context.Context) error {
+	return sc.connection.connect(sc.stream.Connect, sc.stream.Terminated)
+}
+
+// Close closes the stock stream connection. The subscriptions and sessions stop receiving events,
+// and subscribing afterwards returns ErrStreamClosed.
+func (sc *StocksClient) Close() {
+	sc.connection.close()
+}
+
+var (
+	// ErrStreamClosed is returned when subscribing after the stream has been closed.
+	ErrStreamClosed = errors.New("stream closed")
+	// ErrStreamTerminated is returned when subscribing after the stream has terminated,
+	// e.g. because it could not reconnect.
+	ErrStreamTerminated = errors.New("stream terminated")
+)
+
+// streamConnection connects a stream once, with a context it owns, so that the connection does not end
+// with the context of the first subscriber. The stream client cannot be connected again after a failed
+// attempt or once terminated, so that error is returned to every later caller.
+type streamConnection struct {
+	once   sync.Once
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	closed bool
+	err    error
+}
+
+func (c *streamConnection) connect(connect func(context.Context) error, terminated func() <-chan error) error {
+	c.once.Do(func() {
+		c.mu.Lock()
+		if c.closed {
+			c.mu.Unlock()
+			return
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		c.cancel = cancel
+		c.mu.Unlock()
+
+		if err := connect(ctx); err != nil {
+			cancel()
+			c.fail(err)
+			return
+		}
+		go func() {
+			if err := <-terminated(); err != nil {
+				c.fail(fmt.Errorf("%w: %w", ErrStreamTerminated, err))
+				return
+			}
+			c.fail(ErrStreamTerminated)
+		}()
+	})
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+func (c *streamConnection) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	if c.err == nil {
+		c.err = ErrStreamClosed
+	}
+	if c.cancel != nil {
+		c.cancel()
+	}
+}
+
+// fail records err unless an error is already recorded, so that closing the stream is not reported
+// as a termination.
+func (c *streamConnection) fail(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err == nil {
+		c.err = err
+	}
+}
+
+func (sc *StocksClient) handle(ctx context.Context, kind string, handle func(context.Context) error) {
+	if err := handle(ctx); err != nil {
+		// Use a StreamSession to unsubscribe or terminate when a handler keeps failing.
+		sc.logger.Error("handling "+kind, slog.Any("error", err))
+	}
+}
+
+func newBar(bar stream.Bar) *model.Bar {
+	return &model.Bar{
+		Symbol:                     bar.Symbol,
+		Open:                       decimal.NewFromFloat(bar.Open),
+		High:                       decimal.NewFromFloat(bar.High),
+		Low:                        decimal.NewFromFloat(bar.Low),
+		Close:                      decimal.NewFromFloat(bar.Close),
+		Volume:                     bar.Volume,
+		VolumeWeightedAveragePrice: decimal.NewFromFloat(bar.VWAP),
+		TradeCount:                 bar.TradeCount,
+		Timestamp:                  bar.Timestamp,
+	}
+}
+
+func newQuote(quote stream.Quote) *model.SymbolQuote {
+	conditions := make([]model.QuoteCondition, len(quote.Conditions))
+	for i, c := range quote.Conditions {
+		conditions[i] = model.QuoteCondition(c)
+	}
+	return &model.SymbolQuote{
+		Symbol: quote.Symbol,
+		Quote: model.Quote{
+			AskPrice:    decimal.NewFromFloat(quote.AskPrice),
+			AskSize:     uint64(quote.AskSize),
+			AskExchange: quote.AskExchange,
+			BidPrice:    decimal.NewFromFloat(quote.BidPrice),
+			BidSize:     uint64(quote.BidSize),
+			BidExchange: quote.BidExchange,
+			Conditions:  conditions,
+			Tape:        model.Tape(quote.Tape),
+			Timestamp:   quote.Timestamp,
+		},
+	}
+}
+
+func newTrade(trade stream.Trade) *model.SymbolTrade {
+	conditions := make([]model.TradeCondition, len(trade.Conditions))
+	for i, c := range trade.Conditions {
+		conditions[i] = model.TradeCondition(c)
+	}
+	return &model.SymbolTrade{
+		Symbol: trade.Symbol,
+		Trade: model.Trade{
+			ID:         trade.ID,
+			Price:      decimal.NewFromFloat(trade.Price),
+			Size:       uint64(trade.Size),
+			Exchange:   trade.Exchange,
+			Conditions: conditions,
+			Tape:       model.Tape(trade.Tape),
+			Timestamp:  trade.Timestamp,
+		},
+	}
+}
+
+func newTradingStatus(status stream.TradingStatus) *model.TradingStatus {
+	return &model.TradingStatus{
+		Symbol:     status.Symbol,
+		StatusCode: model.TradingStatusCode(status.StatusCode),
+		StatusMsg:  status.StatusMsg,
+		ReasonCode: status.ReasonCode,
+		ReasonMsg:  status.ReasonMsg,
+		Tape:       model.Tape(status.Tape),
+		Timestamp:  status.Timestamp,
+	}
+}
+
+func newLULD(luld stream.LULD) *model.LULD {
+	return &model.LULD{
+		Symbol:         luld.Symbol,
+		LimitUpPrice:   decimal.NewFromFloat(luld.LimitUpPrice),
+		LimitDownPrice: decimal.NewFromFloat(luld.LimitDownPrice),
+		Indicator:      luld.Indicator,
+		Tape:           model.Tape(luld.Tape),
+		Timestamp:      luld.Timestamp,
+	}
+}
@@ -174,7 +174,7 @@ type Identity struct {
 	FundingSource     []string         `json:"funding_source"`
 	AnnualIncomeMin   *decimal.Decimal `json:"annual_income_min"`
 	AnnualIncomeMax   *decimal.Decimal `json:"annual_income_max"`
-	LiquidNetWorthMin *decimal.Decimal `json:"liguid_net_worth_min"`
+	LiquidNetWorthMin *decimal.Decimal `json:"liquid_net_worth_min"`
 	LiquidNetWorthMax *decimal.Decimal `json:"liquid_net_worth_max"`
 	TotalNetWorthMin  *decimal.Decimal `json:"total_net_worth_min"`
 	TotalNetWorthMax  *decimal.Decimal `json:"total_net_worth_max"`
@@ -194,7 +194,7 @@ type Agreement struct {
 
 type Disclosures struct {
 	EmploymentStatus            *string             `json:"employment_status"`
-	EmployerName                *string             `json:"empoyer_name"`
+	EmployerName                *string             `json:"employer_name"`
 	EmployerAddress             *string             `json:"employer_address"`
 	EmploymentPosition          *string             `json:"employment_position"`
 	IsControlPerson             bool                `json:"is_control_person"`
@@ -314,3 +314,156 @@ type UpdateOnfidoSDKOutcomeRequest struct {
 	Outcome string  `json:"outcome"`
 	Reason  *string `json:"reason"`
 }
+
+type UpdateAccountParams struct {
+	AccountID string `path:"account_id,required"`
+}
+
+// UpdateAccountRequest updates the account partially. Only the entities and fields that are set are sent,
+// so a field is cleared by pointing it to its zero value, e.g. an empty string.
+//
+// The update types cannot reuse Contact, Identity, Disclosures and TrustedContact: those serialize required
+// fields and flags such as IsControlPerson by value, so an unset field could not be told apart from a cleared
+// or false one. Fields added to the create types must also be added to their update counterparts.
+type UpdateAccountRequest struct {
+	Contact        *UpdateContact        `json:"contact,omitempty"`
+	Identity       *UpdateIdentity       `json:"identity,omitempty"`
+	Disclosures    *UpdateDisclosures    `json:"disclosures,omitempty"`
+	TrustedContact *UpdateTrustedContact `json:"trusted_contact,omitempty"`
+}
+
+// UpdateContact is the partial update of a Contact.
+type UpdateContact struct {
+	EmailAddress  *string   `json:"email_address,omitempty"`
+	PhoneNumber   *string   `json:"phone_number,omitempty"`
+	StreetAddress *[]string `json:"street_address,omitempty"`
+	Unit          *string   `json:"unit,omitempty"`
+	City          *string   `json:"city,omitempty"`
+	State         *string   `json:"state,omitempty"`
+	PostalCode    *string   `json:"postal_code,omitempty"`
+}
+
+// UpdateTrustedContact is the partial update of a TrustedContact.
+type UpdateTrustedContact struct {
+	GivenName     *string   `json:"given_name,omitempty"`
+	FamilyName    *string   `json:"family_name,omitempty"`
+	EmailAddress  *string   `json:"email_address,omitempty"`
+	PhoneNumber   *string   `json:"phone_number,omitempty"`
+	StreetAddress *[]string `json:"street_address,omitempty"`
+	Unit          *string   `json:"unit,omitempty"`
+	City          *string   `json:"city,omitempty"`
+	State         *string   `json:"state,omitempty"`
+	PostalCode    *string   `json:"postal_code,omitempty"`
+	Country       *string   `json:"country,omitempty"`
+}
+
+// UpdateIdentity is the partial update of an Identity.
+type UpdateIdentity struct {
+	GivenName             *string `json:"given_name,omitempty"`
+	FamilyName            *string `json:"family_name,omitempty"`
+	MiddleName            *string `json:"middle_name,omitempty"`
+	DateOfBirth           *string `json:"date_of_birth,omitempty"`
+	TaxID                 *string `json:"tax_id,omitempty"`
+	TaxIDType             *string `json:"tax_id_type,omitempty"`
+	CountryOfCitizenship  *string `json:"country_of_citizenship,omitempty"`
+	CountryOfBirth        *string `json:"country_of_birth,omitempty"`
+	CountryOfTaxResidence *string `json:"country_of_tax_residence,omitempty"`
+
+	FundingSource     *[]string        `json:"funding_source,omitempty"`
+	AnnualIncomeMin   *decimal.Decimal `json:"annual_income_min,omitempty"`
+	AnnualIncomeMax   *decimal.Decimal `json:"annual_income_max,omitempty"`
+	LiquidNetWorthMin *decimal.Decimal `json:"liquid_net_worth_min,omitempty"`
+	LiquidNetWorthMax *decimal.Decimal `json:"liquid_net_worth_max,omitempty"`
+	TotalNetWorthMin  *decimal.Decimal `json:"total_net_worth_min,omitempty"`
+	TotalNetWorthMax  *decimal.Decimal `json:"total_net_worth_max,omitempty"`
+
+	VisaType               *string    `json:"visa_type,omitempty"`
+	VisaExpirationDate     *time.Time `json:"visa_expiration_date,omitempty"`
+	DateOfDepartureFromUsa *time.Time `json:"date_of_departure_from_usa,omitempty"`
+	PermanentResident      *bool      `json:"permanent_resident,omitempty"`
+}
+
+// UpdateDisclosures is the partial update of Disclosures.
+type UpdateDisclosures struct {
+	EmploymentStatus            *string              `json:"employment_status,omitempty"`
+	EmployerName                *string              `json:"employer_name,omitempty"`
+	EmployerAddress             *string              `json:"employer_address,omitempty"`
+	EmploymentPosition          *string              `json:"employment_position,omitempty"`
+	IsControlPerson             *bool                `json:"is_control_person,omitempty"`
+	IsAffiliatedExchangeOrFinra *bool                `json:"is_affiliated_exchange_or_finra,omitempty"`
+	IsAffiliatedExchangeOrIiroc *bool                `json:"is_affiliated_exchange_or_iiroc,omitempty"`
+	IsPoliticallyExposed        *bool                `json:"is_politically_exposed,omitempty"`
+	ImmediateFamilyExposed      *bool                `json:"immediate_family_exposed,omitempty"`
+	Context                     *[]DisclosureContext `json:"context,omitempty"`
+}
+
+type UpdateAccountResponse struct {
+	Account
+}
+
+type CloseAccountParams struct {
+	AccountID string `path:"account_id,required"`
+}
+
+type ReopenAccountParams struct {
+	AccountID string `path:"account_id,required"`
+}
+
+// TradingConfigurations are the trading settings of an account.
+type TradingConfigurations struct {
+	DTBPCheck         DayTradeCheck     `json:"dtbp_check"`
+	PDTCheck          DayTradeCheck     `json:"pdt_check"`
+	TradeConfirmEmail TradeConfirmEmail `json:"trade_confirm_email"`
+	SuspendTrade      bool              `json:"suspend_trade"`
+	NoShorting        bool              `json:"no_shorting"`
+	FractionalTrading bool              `json:"fractional_trading"`
+	// MaxMarginMultiplier is either "1", "2" or "4".
+	MaxMarginMultiplier    string `json:"max_margin_multiplier"`
+	MaxOptionsTradingLevel *int   `json:"max_options_trading_level"`
+	PTPNoExceptionEntry    bool   `json:"ptp_no_exception_entry"`
+}
+
+// DayTradeCheck defines when the day trading buying power or pattern day trader checks are applied.
+type DayTradeCheck string
+
+const (
+	DayTradeCheckEntry DayTradeCheck = "entry"
+	DayTradeCheckExit  DayTradeCheck = "exit"
+	DayTradeCheckBoth  DayTradeCheck = "both"
+)
+
+type TradeConfirmEmail string
+
+const (
+	TradeConfirmEmailAll  TradeConfirmEmail = "all"
+	TradeConfirmEmailNone TradeConfirmEmail = "none"
+)
+
+type GetTradingConfigurationsParams struct {
+	AccountID string `path:"account_id,required"`
+}
+
+type GetTradingConfigurationsResponse struct {
+	TradingConfigurations
+}
+
+type UpdateTradingConfigurationsParams struct {
+	AccountID string `path:"account_id,required"`
+}
+
+// UpdateTradingConfigurationsRequest updates the trading configurations partially. Only the fields that are set are sent.
+type UpdateTradingConfigurationsRequest struct {
+	DTBPCheck              *DayTradeCheck     `json:"dtbp_check,omitempty"`
+	PDTCheck               *DayTradeCheck     `json:"pdt_check,omitempty"`
+	TradeConfirmEmail      *TradeConfirmEmail `json:"trade_confirm_email,omitempty"`
+	SuspendTrade           *bool              `json:"suspend_trade,omitempty"`
+	NoShorting             *bool              `json:"no_shorting,omitempty"`
+	FractionalTrading      *bool              `json:"fractional_trading,omitempty"`
+	MaxMarginMultiplier    *string            `json:"max_margin_multiplier,omitempty"`
+	MaxOptionsTradingLevel *int               `json:"max_options_trading_level,omitempty"`
+	PTPNoExceptionEntry    *bool              `json:"ptp_no_exception_entry,omitempty"`
+}
+
+type UpdateTradingConfigurationsResponse struct {
+	TradingConfigurations
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.tradeforge.dev/alpaca/util"
+)
+
+func TestUpdateAccountRequestOmitsUnsetFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		request UpdateAccountRequest
+		want    string
+	}{
+		{
+			name:    "empty",
+			request: UpdateAccountRequest{},
+			want:    `{}`,
+		},
+		{
+			name:    "contact field",
+			request: UpdateAccountRequest{Contact: &UpdateContact{City: util.AsPtr("Prague")}},
+			want:    `{"contact":{"city":"Prague"}}`,
+		},
+		{
+			name:    "cleared field",
+			request: UpdateAccountRequest{Identity: &UpdateIdentity{MiddleName: util.AsPtr("")}},
+			want:    `{"identity":{"middle_name":""}}`,
+		},
+		{
+			name:    "false flag",
+			request: UpdateAccountRequest{Disclosures: &UpdateDisclosures{IsControlPerson: util.AsPtr(false)}},
+			want:    `{"disclosures":{"is_control_person":false}}`,
+		},
+		{
+			name:    "empty trusted contact",
+			request: UpdateAccountRequest{TrustedContact: &UpdateTrustedContact{}},
+			want:    `{"trusted_contact":{}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.request)
+			if err != nil {
+				t.Fatalf("marshalling: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
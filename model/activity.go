@@ -0,0 +1,196 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ActivityType is the type of an account activity.
+//
+// See https://docs.alpaca.markets/docs/account-activities.
+type ActivityType string
+
+const (
+	// ActivityTypeFill is an order fill, either partial or full.
+	ActivityTypeFill ActivityType = "FILL"
+	// ActivityTypeTransaction is a cash transaction, either a deposit or a withdrawal.
+	ActivityTypeTransaction ActivityType = "TRANS"
+	// ActivityTypeMisc is a miscellaneous or rarely used activity.
+	ActivityTypeMisc ActivityType = "MISC"
+	// ActivityTypeACATSIn is an ACATS transfer into the account.
+	ActivityTypeACATSIn ActivityType = "ACATC"
+	// ActivityTypeACATSOut is an ACATS transfer out of the account.
+	ActivityTypeACATSOut ActivityType = "ACATS"
+	// ActivityTypeCashDeposit is a cash deposit.
+	ActivityTypeCashDeposit ActivityType = "CSD"
+	// ActivityTypeCashWithdrawal is a cash withdrawal.
+	ActivityTypeCashWithdrawal ActivityType = "CSW"
+	// ActivityTypeDividend is a dividend.
+	ActivityTypeDividend ActivityType = "DIV"
+	// ActivityTypeDividendCapitalGainLongTerm is a long term capital gain dividend.
+	ActivityTypeDividendCapitalGainLongTerm ActivityType = "DIVCGL"
+	// ActivityTypeDividendCapitalGainShortTerm is a short term capital gain dividend.
+	ActivityTypeDividendCapitalGainShortTerm ActivityType = "DIVCGS"
+	// ActivityTypeDividendNRA is a dividend adjustment for NRA withholding.
+	ActivityTypeDividendNRA ActivityType = "DIVNRA"
+	// ActivityTypeDividendReturnOfCapital is a return of capital dividend.
+	ActivityTypeDividendReturnOfCapital ActivityType = "DIVROC"
+	// ActivityTypeDividendTaxExempt is a tax exempt dividend.
+	ActivityTypeDividendTaxExempt ActivityType = "DIVTXEX"
+	// ActivityTypeFee is a fee.
+	ActivityTypeFee ActivityType = "FEE"
+	// ActivityTypeInterest is an interest credit or debit.
+	ActivityTypeInterest ActivityType = "INT"
+	// ActivityTypeJournal is a cash or security journal.
+	ActivityTypeJournal ActivityType = "JNL"
+	// ActivityTypeJournalCash is a cash journal.
+	ActivityTypeJournalCash ActivityType = "JNLC"
+	// ActivityTypeJournalSecurity is a security journal.
+	ActivityTypeJournalSecurity ActivityType = "JNLS"
+	// ActivityTypeMerger is a merger or acquisition.
+	ActivityTypeMerger ActivityType = "MA"
+	// ActivityTypeNameChange is a name change.
+	ActivityTypeNameChange ActivityType = "NC"
+	// ActivityTypeOptionAssignment is an option assignment.
+	ActivityTypeOptionAssignment ActivityType = "OPASN"
+	// ActivityTypeOptionExpiration is an option expiration.
+	ActivityTypeOptionExpiration ActivityType = "OPEXP"
+	// ActivityTypeOptionExercise is an option exercise.
+	ActivityTypeOptionExercise ActivityType = "OPXRC"
+	// ActivityTypePassThruCharge is a pass-through charge.
+	ActivityTypePassThruCharge ActivityType = "PTC"
+	// ActivityTypePassThruRebate is a pass-through rebate.
+	ActivityTypePassThruRebate ActivityType = "PTR"
+	// ActivityTypeReorg is a reorganization fee.
+	ActivityTypeReorg ActivityType = "REORG"
+	// ActivityTypeSpinOff is a spin-off.
+	ActivityTypeSpinOff ActivityType = "SPIN"
+	// ActivityTypeSplit is a stock split.
+	ActivityTypeSplit ActivityType = "SPLIT"
+)
+
+// Activity is an account activity, either a TradeActivity or a NonTradeActivity.
+type Activity interface {
+	// Type returns the type of the activity.
+	Type() ActivityType
+}
+
+// Activities is a list of account activities decoded by their activity type.
+type Activities []Activity
+
+// UnmarshalJSON decodes every activity into a TradeActivity if it is a fill, or into a NonTradeActivity otherwise.
+func (a *Activities) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	activities := make(Activities, 0, len(raw))
+	for _, r := range raw {
+		activity, err := unmarshalActivity(r)
+		if err != nil {
+			return err
+		}
+		activities = append(activities, activity)
+	}
+	*a = activities
+	return nil
+}
+
+func unmarshalActivity(data []byte) (Activity, error) {
+	var header struct {
+		ActivityType ActivityType `json:"activity_type"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("unmarshalling activity type: %w", err)
+	}
+
+	var activity Activity
+	if header.ActivityType == ActivityTypeFill {
+		activity = &TradeActivity{}
+	} else {
+		activity = &NonTradeActivity{}
+	}
+	if err := json.Unmarshal(data, activity); err != nil {
+		return nil, fmt.Errorf("unmarshalling %s activity: %w", header.ActivityType, err)
+	}
+	return activity, nil
+}
+
+// TradeActivity is an order fill.
+type TradeActivity struct {
+	ID              string          `json:"id"`
+	AccountID       uuid.UUID       `json:"account_id"`
+	ActivityType    ActivityType    `json:"activity_type"`
+	TransactionTime time.Time       `json:"transaction_time"`
+	FillType        FillType        `json:"type"`
+	OrderID         uuid.UUID       `json:"order_id"`
+	OrderStatus     string          `json:"order_status"`
+	Symbol          string          `json:"symbol"`
+	Side            string          `json:"side"`
+	Price           decimal.Decimal `json:"price"`
+	Quantity        decimal.Decimal `json:"qty"`
+	// CumulativeQuantity is the quantity filled so far, including this fill.
+	CumulativeQuantity decimal.Decimal `json:"cum_qty"`
+	// LeavesQuantity is the quantity of the order that remains to be filled.
+	LeavesQuantity decimal.Decimal `json:"leaves_qty"`
+}
+
+func (a *TradeActivity) Type() ActivityType {
+	return a.ActivityType
+}
+
+type FillType string
+
+const (
+	FillTypeFill        FillType = "fill"
+	FillTypePartialFill FillType = "partial_fill"
+)
+
+// NonTradeActivity is any activity other than a fill, such as a dividend, a fee or a cash movement.
+type NonTradeActivity struct {
+	ID           string       `json:"id"`
+	AccountID    uuid.UUID    `json:"account_id"`
+	ActivityType ActivityType `json:"activity_type"`
+	// Date is the date of the activity in YYYY-MM-DD format.
+	Date        string          `json:"date"`
+	NetAmount   decimal.Decimal `json:"net_amount"`
+	Description string          `json:"description"`
+	Status      string          `json:"status"`
+	// Symbol, Quantity and PerShareAmount are only set for activities related to a security.
+	Symbol         *string          `json:"symbol"`
+	Quantity       *decimal.Decimal `json:"qty"`
+	PerShareAmount *decimal.Decimal `json:"per_share_amount"`
+	GroupID        *string          `json:"group_id"`
+	CreatedAt      *time.Time       `json:"created_at"`
+}
+
+func (a *NonTradeActivity) Type() ActivityType {
+	return a.ActivityType
+}
+
+type ListActivitiesParams struct {
+	AccountID *string `query:"account_id,omitempty"`
+	// ActivityTypes is a comma-separated list of activity types to filter by. It is ignored by ListActivitiesByType.
+	ActivityTypes *string `query:"activity_types,omitempty"`
+	// Date is a date in YYYY-MM-DD format. It cannot be used together with After or Until.
+	Date      *string    `query:"date,omitempty"`
+	After     *time.Time `query:"after,omitempty"`
+	Until     *time.Time `query:"until,omitempty"`
+	Direction *string    `query:"direction,omitempty"`
+	PageSize  *int       `query:"page_size,omitempty"`
+	// PageToken is the ID of the last activity of the previous page.
+	PageToken *string `query:"page_token,omitempty"`
+}
+
+type ListActivitiesByTypeParams struct {
+	ActivityType ActivityType `path:"activity_type"`
+	// The embedded struct must be tagged, otherwise the encoder skips it in explicit mode.
+	ListActivitiesParams `query:",inline"`
+}
+
+type ListActivitiesResponse = Activities
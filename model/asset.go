@@ -5,8 +5,14 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+const (
+	AssetClassUSEquity = "us_equity"
+	AssetClassUSOption = "us_option"
+	AssetClassCrypto   = "crypto"
+)
+
 type ListAssetsParams struct {
-	// AssetClass is the asset class to filter by. Valid values are "us_equity", "crypto"
+	// AssetClass is the asset class to filter by. Valid values are "us_equity", "us_option", "crypto"
 	AssetClass string `query:"asset_class"`
 	// AssetStatus is the status of the asset. Valid values are "active", "inactive", or "all".
 	Status string `json:"status"`
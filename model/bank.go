@@ -0,0 +1,147 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ACHRelationship represents a bank account linked to an account for ACH transfers.
+type ACHRelationship struct {
+	ID                uuid.UUID             `json:"id"`
+	AccountID         uuid.UUID             `json:"account_id"`
+	Status            ACHRelationshipStatus `json:"status"`
+	AccountOwnerName  string                `json:"account_owner_name"`
+	BankAccountType   BankAccountType       `json:"bank_account_type"`
+	BankAccountNumber string                `json:"bank_account_number"`
+	BankRoutingNumber string                `json:"bank_routing_number"`
+	Nickname          *string               `json:"nickname"`
+	ProcessorToken    *string               `json:"processor_token"`
+	CreatedAt         time.Time             `json:"created_at"`
+	UpdatedAt         time.Time             `json:"updated_at"`
+}
+
+type ACHRelationshipStatus string
+
+const (
+	// ACHRelationshipStatusQueued represents a relationship that is in queue to be processed.
+	ACHRelationshipStatusQueued ACHRelationshipStatus = "QUEUED"
+	// ACHRelationshipStatusApproved represents a relationship that is approved.
+	ACHRelationshipStatusApproved ACHRelationshipStatus = "APPROVED"
+	// ACHRelationshipStatusPending represents a relationship that is pending approval.
+	ACHRelationshipStatusPending ACHRelationshipStatus = "PENDING"
+	// ACHRelationshipStatusCancelRequested represents a relationship whose cancellation has been requested.
+	ACHRelationshipStatusCancelRequested ACHRelationshipStatus = "CANCEL_REQUESTED"
+)
+
+type BankAccountType string
+
+const (
+	BankAccountTypeChecking BankAccountType = "CHECKING"
+	BankAccountTypeSavings  BankAccountType = "SAVINGS"
+)
+
+type CreateACHRelationshipParams struct {
+	AccountID string `path:"account_id"`
+}
+
+// CreateACHRelationshipRequest links a bank account either with its account and routing numbers or with a Plaid processor token.
+type CreateACHRelationshipRequest struct {
+	AccountOwnerName  string          `json:"account_owner_name,omitempty"`
+	BankAccountType   BankAccountType `json:"bank_account_type,omitempty"`
+	BankAccountNumber string          `json:"bank_account_number,omitempty"`
+	BankRoutingNumber string          `json:"bank_routing_number,omitempty"`
+	Nickname          *string         `json:"nickname,omitempty"`
+	ProcessorToken    *string         `json:"processor_token,omitempty"`
+}
+
+type CreateACHRelationshipResponse struct {
+	ACHRelationship
+}
+
+type ListACHRelationshipsParams struct {
+	AccountID string `path:"account_id"`
+	// Statuses is a comma-separated list of statuses to filter by.
+	Statuses *string `query:"statuses,omitempty"`
+}
+
+type ListACHRelationshipsResponse = []ACHRelationship
+
+type DeleteACHRelationshipParams struct {
+	AccountID         string `path:"account_id"`
+	ACHRelationshipID string `path:"ach_relationship_id"`
+}
+
+// Bank represents a recipient bank linked to an account for wire transfers.
+type Bank struct {
+	ID            uuid.UUID    `json:"id"`
+	AccountID     uuid.UUID    `json:"account_id"`
+	Name          string       `json:"name"`
+	Status        BankStatus   `json:"status"`
+	Country       string       `json:"country"`
+	StateProvince string       `json:"state_province"`
+	PostalCode    string       `json:"postal_code"`
+	City          string       `json:"city"`
+	StreetAddress string       `json:"street_address"`
+	AccountNumber string       `json:"account_number"`
+	BankCode      string       `json:"bank_code"`
+	BankCodeType  BankCodeType `json:"bank_code_type"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+}
+
+type BankStatus string
+
+const (
+	// BankStatusQueued represents a bank relationship that is in queue to be processed.
+	BankStatusQueued BankStatus = "QUEUED"
+	// BankStatusSentToClearing represents a bank relationship that is being processed by the clearing firm.
+	BankStatusSentToClearing BankStatus = "SENT_TO_CLEARING"
+	// BankStatusApproved represents a bank relationship that is approved.
+	BankStatusApproved BankStatus = "APPROVED"
+	// BankStatusCanceled represents a bank relationship that is canceled.
+	BankStatusCanceled BankStatus = "CANCELED"
+)
+
+type BankCodeType string
+
+const (
+	// BankCodeTypeABA is used for domestic banks.
+	BankCodeTypeABA BankCodeType = "ABA"
+	// BankCodeTypeBIC is used for international banks.
+	BankCodeTypeBIC BankCodeType = "BIC"
+)
+
+type CreateBankParams struct {
+	AccountID string `path:"account_id"`
+}
+
+type CreateBankRequest struct {
+	Name          string       `json:"name"`
+	BankCode      string       `json:"bank_code"`
+	BankCodeType  BankCodeType `json:"bank_code_type"`
+	AccountNumber string       `json:"account_number"`
+	// Country, StateProvince, PostalCode, City and StreetAddress are only required for international banks.
+	Country       *string `json:"country,omitempty"`
+	StateProvince *string `json:"state_province,omitempty"`
+	PostalCode    *string `json:"postal_code,omitempty"`
+	City          *string `json:"city,omitempty"`
+	StreetAddress *string `json:"street_address,omitempty"`
+}
+
+type CreateBankResponse struct {
+	Bank
+}
+
+type ListBanksParams struct {
+	AccountID string      `path:"account_id"`
+	Status    *BankStatus `query:"status,omitempty"`
+	BankName  *string     `query:"bank_name,omitempty"`
+}
+
+type ListBanksResponse = []Bank
+
+type DeleteBankParams struct {
+	AccountID string `path:"account_id"`
+	BankID    string `path:"bank_id"`
+}
@@ -0,0 +1,267 @@
+package model
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Announcement is a corporate action announcement of the broker API.
+type Announcement struct {
+	ID                      uuid.UUID           `json:"id"`
+	CorporateActionID       string              `json:"corporate_action_id"`
+	Type                    AnnouncementType    `json:"ca_type"`
+	SubType                 AnnouncementSubType `json:"ca_sub_type"`
+	InitiatingSymbol        string              `json:"initiating_symbol"`
+	InitiatingOriginalCUSIP string              `json:"initiating_original_cusip"`
+	TargetSymbol            *string             `json:"target_symbol"`
+	TargetOriginalCUSIP     *string             `json:"target_original_cusip"`
+	// DeclarationDate, ExDate, RecordDate and PayableDate are dates in YYYY-MM-DD format.
+	DeclarationDate *string          `json:"declaration_date"`
+	ExDate          *string          `json:"ex_date"`
+	RecordDate      *string          `json:"record_date"`
+	PayableDate     *string          `json:"payable_date"`
+	Cash            *decimal.Decimal `json:"cash"`
+	OldRate         *decimal.Decimal `json:"old_rate"`
+	NewRate         *decimal.Decimal `json:"new_rate"`
+}
+
+type AnnouncementType string
+
+const (
+	AnnouncementTypeDividend AnnouncementType = "dividend"
+	AnnouncementTypeMerger   AnnouncementType = "merger"
+	AnnouncementTypeSpinoff  AnnouncementType = "spinoff"
+	AnnouncementTypeSplit    AnnouncementType = "split"
+)
+
+type AnnouncementSubType string
+
+const (
+	AnnouncementSubTypeCash             AnnouncementSubType = "cash"
+	AnnouncementSubTypeStock            AnnouncementSubType = "stock"
+	AnnouncementSubTypeMergerUpdate     AnnouncementSubType = "merger_update"
+	AnnouncementSubTypeMergerCompletion AnnouncementSubType = "merger_completion"
+	AnnouncementSubTypeSpinoff          AnnouncementSubType = "spinoff"
+	AnnouncementSubTypeStockSplit       AnnouncementSubType = "stock_split"
+	AnnouncementSubTypeUnitSplit        AnnouncementSubType = "unit_split"
+	AnnouncementSubTypeReverseSplit     AnnouncementSubType = "reverse_split"
+	AnnouncementSubTypeRecapitalization AnnouncementSubType = "recapitalization"
+	AnnouncementSubTypeRedemption       AnnouncementSubType = "redemption"
+)
+
+type ListAnnouncementsParams struct {
+	// Types is a comma-separated list of announcement types.
+	Types string `query:"ca_types" validate:"required"`
+	// Since and Until are dates in YYYY-MM-DD format. They may be at most 90 days apart.
+	Since    string  `query:"since" validate:"required"`
+	Until    string  `query:"until" validate:"required"`
+	Symbol   *string `query:"symbol,omitempty"`
+	CUSIP    *string `query:"cusip,omitempty"`
+	DateType *string `query:"date_type,omitempty"`
+}
+
+type ListAnnouncementsResponse = []Announcement
+
+type GetAnnouncementParams struct {
+	AnnouncementID string `path:"announcement_id"`
+}
+
+type GetAnnouncementResponse struct {
+	Announcement
+}
+
+// CorporateActionType is a type of corporate action of the market data API.
+type CorporateActionType string
+
+const (
+	CorporateActionTypeForwardSplit       CorporateActionType = "forward_split"
+	CorporateActionTypeReverseSplit       CorporateActionType = "reverse_split"
+	CorporateActionTypeCashDividend       CorporateActionType = "cash_dividend"
+	CorporateActionTypeStockDividend      CorporateActionType = "stock_dividend"
+	CorporateActionTypeCashMerger         CorporateActionType = "cash_merger"
+	CorporateActionTypeStockMerger        CorporateActionType = "stock_merger"
+	CorporateActionTypeStockAndCashMerger CorporateActionType = "stock_and_cash_merger"
+	CorporateActionTypeSpinOff            CorporateActionType = "spin_off"
+	CorporateActionTypeNameChange         CorporateActionType = "name_change"
+)
+
+type GetCorporateActionsParams struct {
+	// Symbols is a comma-separated list of symbols.
+	Symbols *string `query:"symbols,omitempty"`
+	// Types is a comma-separated list of corporate action types.
+	Types *string `query:"types,omitempty"`
+	// Start and End are dates in YYYY-MM-DD format.
+	Start     *string `query:"start,omitempty"`
+	End       *string `query:"end,omitempty"`
+	Limit     *int    `query:"limit,omitempty"`
+	Sort      *string `query:"sort,omitempty"`
+	PageToken *string `query:"page_token,omitempty"`
+}
+
+type GetCorporateActionsResponse struct {
+	CorporateActions CorporateActions `json:"corporate_actions"`
+	NextPageToken    *string          `json:"next_page_token"`
+}
+
+// CorporateActions are the corporate actions of the market data API grouped by type.
+// All dates are in YYYY-MM-DD format.
+type CorporateActions struct {
+	ForwardSplits       []ForwardSplit       `json:"forward_splits"`
+	ReverseSplits       []ReverseSplit       `json:"reverse_splits"`
+	CashDividends       []CashDividend       `json:"cash_dividends"`
+	StockDividends      []StockDividend      `json:"stock_dividends"`
+	CashMergers         []CashMerger         `json:"cash_mergers"`
+	StockMergers        []StockMerger        `json:"stock_mergers"`
+	StockAndCashMergers []StockAndCashMerger `json:"stock_and_cash_mergers"`
+	SpinOffs            []SpinOff            `json:"spin_offs"`
+	NameChanges         []NameChange         `json:"name_changes"`
+}
+
+// Splits returns the forward and reverse splits.
+func (a CorporateActions) Splits() []Split {
+	splits := make([]Split, 0, len(a.ForwardSplits)+len(a.ReverseSplits))
+	for _, s := range a.ForwardSplits {
+		splits = append(splits, s.Split)
+	}
+	for _, s := range a.ReverseSplits {
+		splits = append(splits, s.Split)
+	}
+	return splits
+}
+
+// Split is a change of the number of shares: every OldRate shares become NewRate shares.
+type Split struct {
+	Symbol      string          `json:"symbol"`
+	NewRate     decimal.Decimal `json:"new_rate"`
+	OldRate     decimal.Decimal `json:"old_rate"`
+	ProcessDate string          `json:"process_date"`
+	ExDate      string          `json:"ex_date"`
+	RecordDate  *string         `json:"record_date"`
+	PayableDate *string         `json:"payable_date"`
+}
+
+// Ratio returns the number of new shares per old share.
+func (s Split) Ratio() decimal.Decimal {
+	if s.OldRate.IsZero() {
+		return decimal.NewFromInt(1)
+	}
+	return s.NewRate.Div(s.OldRate)
+}
+
+type ForwardSplit struct {
+	Split
+	DueBillRedemptionDate *string `json:"due_bill_redemption_date"`
+}
+
+type ReverseSplit struct {
+	Split
+}
+
+type CashDividend struct {
+	Symbol         string          `json:"symbol"`
+	Rate           decimal.Decimal `json:"rate"`
+	Special        bool            `json:"special"`
+	Foreign        bool            `json:"foreign"`
+	ProcessDate    string          `json:"process_date"`
+	ExDate         string          `json:"ex_date"`
+	RecordDate     *string         `json:"record_date"`
+	PayableDate    *string         `json:"payable_date"`
+	DueBillOnDate  *string         `json:"due_bill_on_date"`
+	DueBillOffDate *string         `json:"due_bill_off_date"`
+}
+
+type StockDividend struct {
+	Symbol      string          `json:"symbol"`
+	Rate        decimal.Decimal `json:"rate"`
+	ProcessDate string          `json:"process_date"`
+	ExDate      string          `json:"ex_date"`
+	RecordDate  *string         `json:"record_date"`
+	PayableDate *string         `json:"payable_date"`
+}
+
+type CashMerger struct {
+	AcquirerSymbol *string         `json:"acquirer_symbol"`
+	AcquireeSymbol string          `json:"acquiree_symbol"`
+	Rate           decimal.Decimal `json:"rate"`
+	ProcessDate    string          `json:"process_date"`
+	EffectiveDate  string          `json:"effective_date"`
+	PayableDate    *string         `json:"payable_date"`
+}
+
+type StockMerger struct {
+	AcquirerSymbol string          `json:"acquirer_symbol"`
+	AcquirerRate   decimal.Decimal `json:"acquirer_rate"`
+	AcquireeSymbol string          `json:"acquiree_symbol"`
+	AcquireeRate   decimal.Decimal `json:"acquiree_rate"`
+	ProcessDate    string          `json:"process_date"`
+	EffectiveDate  string          `json:"effective_date"`
+	PayableDate    *string         `json:"payable_date"`
+}
+
+type StockAndCashMerger struct {
+	AcquirerSymbol string          `json:"acquirer_symbol"`
+	AcquirerRate   decimal.Decimal `json:"acquirer_rate"`
+	AcquireeSymbol string          `json:"acquiree_symbol"`
+	AcquireeRate   decimal.Decimal `json:"acquiree_rate"`
+	CashRate       decimal.Decimal `json:"cash_rate"`
+	ProcessDate    string          `json:"process_date"`
+	EffectiveDate  string          `json:"effective_date"`
+	PayableDate    *string         `json:"payable_date"`
+}
+
+type SpinOff struct {
+	SourceSymbol          string          `json:"source_symbol"`
+	SourceRate            decimal.Decimal `json:"source_rate"`
+	NewSymbol             string          `json:"new_symbol"`
+	NewRate               decimal.Decimal `json:"new_rate"`
+	ProcessDate           string          `json:"process_date"`
+	ExDate                string          `json:"ex_date"`
+	RecordDate            *string         `json:"record_date"`
+	PayableDate           *string         `json:"payable_date"`
+	DueBillRedemptionDate *string         `json:"due_bill_redemption_date"`
+}
+
+type NameChange struct {
+	OldSymbol   string `json:"old_symbol"`
+	NewSymbol   string `json:"new_symbol"`
+	ProcessDate string `json:"process_date"`
+}
+
+// marketLocation loads the time zone of the ex-dates. It requires the time zone database, either on the
+// host or embedded by importing time/tzdata in the main package.
+var marketLocation = sync.OnceValues(func() (*time.Location, error) {
+	return time.LoadLocation("America/New_York")
+})
+
+// ApplySplits adjusts bars that were requested with the raw adjustment for the given splits, in place.
+// The prices of the bars before the ex-date of a split are divided by its ratio and their volume is multiplied by it.
+// Ex-dates are compared in the America/New_York time zone, so an error is returned if it cannot be loaded.
+func (a HistoricalBarsAggregate) ApplySplits(splits ...Split) error {
+	loc, err := marketLocation()
+	if err != nil {
+		return fmt.Errorf("loading market time zone: %w", err)
+	}
+	for _, split := range splits {
+		ratio := split.Ratio()
+		if ratio.Equal(decimal.NewFromInt(1)) || !ratio.IsPositive() {
+			continue
+		}
+		bars := a[split.Symbol]
+		for i := range bars {
+			if bars[i].Timestamp.In(loc).Format(time.DateOnly) >= split.ExDate {
+				continue
+			}
+			bars[i].Open = bars[i].Open.Div(ratio)
+			bars[i].High = bars[i].High.Div(ratio)
+			bars[i].Low = bars[i].Low.Div(ratio)
+			bars[i].Close = bars[i].Close.Div(ratio)
+			bars[i].VolumeWeightedAveragePrice = bars[i].VolumeWeightedAveragePrice.Div(ratio)
+			bars[i].Volume = decimal.NewFromUint64(bars[i].Volume).Mul(ratio).Round(0).BigInt().Uint64()
+		}
+	}
+	return nil
+}
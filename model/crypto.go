@@ -0,0 +1,149 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CryptoLocationUS is the location of the Alpaca US crypto exchange, the default crypto location.
+const CryptoLocationUS = "us"
+
+// CryptoBar is a crypto bar.
+// It is not a Bar because crypto volumes are fractional.
+type CryptoBar struct {
+	Symbol                     string          `json:"S"`
+	Open                       decimal.Decimal `json:"o"`
+	High                       decimal.Decimal `json:"h"`
+	Low                        decimal.Decimal `json:"l"`
+	Close                      decimal.Decimal `json:"c"`
+	Volume                     decimal.Decimal `json:"v"`
+	VolumeWeightedAveragePrice decimal.Decimal `json:"vw"`
+	TradeCount                 uint64          `json:"n"`
+	Timestamp                  time.Time       `json:"t"`
+}
+
+// CryptoQuote is a crypto quote.
+// It is not a Quote because crypto sizes are fractional and crypto quotes have no exchanges, conditions or tape.
+type CryptoQuote struct {
+	AskPrice  decimal.Decimal `json:"ap"`
+	AskSize   decimal.Decimal `json:"as"`
+	BidPrice  decimal.Decimal `json:"bp"`
+	BidSize   decimal.Decimal `json:"bs"`
+	Timestamp time.Time       `json:"t"`
+}
+
+// SymbolCryptoQuote is a crypto quote along with the symbol it was reported for.
+type SymbolCryptoQuote struct {
+	Symbol string
+	CryptoQuote
+}
+
+// CryptoTrade is a crypto trade.
+type CryptoTrade struct {
+	ID        int64           `json:"i"`
+	Price     decimal.Decimal `json:"p"`
+	Size      decimal.Decimal `json:"s"`
+	TakerSide TakerSide       `json:"tks"`
+	Timestamp time.Time       `json:"t"`
+}
+
+// SymbolCryptoTrade is a crypto trade along with the symbol it was reported for.
+type SymbolCryptoTrade struct {
+	Symbol string
+	CryptoTrade
+}
+
+// TakerSide is the side of the order that took liquidity in a crypto trade.
+type TakerSide string
+
+const (
+	TakerSideBuy     TakerSide = "B"
+	TakerSideSell    TakerSide = "S"
+	TakerSideUnknown TakerSide = "-"
+)
+
+// Orderbook is a snapshot of the bid and ask ladders of a crypto symbol.
+// Bids are sorted by descending price and asks by ascending price.
+type Orderbook struct {
+	Symbol    string           `json:"S"`
+	Bids      []OrderbookEntry `json:"b"`
+	Asks      []OrderbookEntry `json:"a"`
+	Timestamp time.Time        `json:"t"`
+	// Reset is set on streamed orderbooks that replace the whole book instead of updating it.
+	// A streamed entry with a zero size removes the price level.
+	Reset bool `json:"r,omitempty"`
+}
+
+// OrderbookEntry is a price level of an orderbook.
+type OrderbookEntry struct {
+	Price decimal.Decimal `json:"p"`
+	Size  decimal.Decimal `json:"s"`
+}
+
+// CryptoSnapshot is the latest trade, quote and bars of a crypto symbol.
+type CryptoSnapshot struct {
+	LatestTrade    CryptoTrade `json:"latestTrade"`
+	LatestQuote    CryptoQuote `json:"latestQuote"`
+	MinBar         CryptoBar   `json:"minuteBar"`
+	DayBar         CryptoBar   `json:"dailyBar"`
+	PreviousDayBar CryptoBar   `json:"prevDailyBar"`
+}
+
+type GetCryptoBarsParams struct {
+	// Loc is the crypto location. It defaults to CryptoLocationUS.
+	Loc string `path:"loc"`
+	// Symbols is a comma-separated list of crypto pairs, such as BTC/USD.
+	Symbols   string     `query:"symbols,required"`
+	Timeframe string     `query:"timeframe,required"`
+	Start     *time.Time `query:"start,omitempty"`
+	End       *time.Time `query:"end,omitempty"`
+	Limit     *int       `query:"limit,omitempty"`
+	Sort      *string    `query:"sort,omitempty"`
+	PageToken *string    `query:"page_token,omitempty"`
+}
+
+type GetCryptoBarsResponse struct {
+	Bars          map[string][]CryptoBar `json:"bars"`
+	NextPageToken string                 `json:"next_page_token"`
+}
+
+type GetLatestCryptoQuotesParams struct {
+	Loc     string `path:"loc"`
+	Symbols string `query:"symbols,required"`
+}
+
+type GetLatestCryptoQuotesResponse struct {
+	Quotes map[string]CryptoQuote `json:"quotes"`
+}
+
+type GetLatestCryptoTradesParams struct {
+	Loc     string `path:"loc"`
+	Symbols string `query:"symbols,required"`
+}
+
+type GetLatestCryptoTradesResponse struct {
+	Trades map[string]CryptoTrade `json:"trades"`
+}
+
+type GetLatestCryptoOrderbooksParams struct {
+	Loc     string `path:"loc"`
+	Symbols string `query:"symbols,required"`
+}
+
+type GetLatestCryptoOrderbooksResponse struct {
+	Orderbooks map[string]Orderbook `json:"orderbooks"`
+}
+
+type GetCryptoSnapshotsParams struct {
+	Loc     string `path:"loc"`
+	Symbols string `query:"symbols,required"`
+}
+
+type GetCryptoSnapshotsResponse struct {
+	Snapshots map[string]CryptoSnapshot `json:"snapshots"`
+}
+
+type StreamCryptoUpdatesParams struct {
+	Symbols []string `json:"symbols"`
+}
@@ -1,19 +1,37 @@
 package model
 
+import (
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
 type Document struct {
-	DocumentType    string
-	DocumentSubType *string
-	Content         string
-	ContentData     any
-	MimeType        *string
+	DocumentType    string  `json:"document_type"`
+	DocumentSubType *string `json:"document_sub_type,omitempty"`
+	Content         string  `json:"content,omitempty"`
+	ContentData     any     `json:"content_data,omitempty"`
+	MimeType        *string `json:"mime_type,omitempty"`
 }
 
+const (
+	DocumentTypeIdentityVerification    = "identity_verification"
+	DocumentTypeAddressVerification     = "address_verification"
+	DocumentTypeDateOfBirthVerification = "date_of_birth_verification"
+	DocumentTypeTaxIDVerification       = "tax_id_verification"
+	DocumentTypeAccountApprovalLetter   = "account_approval_letter"
+	DocumentTypeCIPResult               = "cip_result"
+	DocumentTypeW8BEN                   = "w8ben"
+)
+
+// UploadDocumentRequest uploads a document. Content is the base64 encoded file.
 type UploadDocumentRequest struct {
-	DocumentType    string
-	DocumentSubType *string
-	Content         string
-	ContentData     any
-	MimeType        *string
+	DocumentType    string  `json:"document_type"`
+	DocumentSubType *string `json:"document_sub_type,omitempty"`
+	Content         string  `json:"content,omitempty"`
+	ContentData     any     `json:"content_data,omitempty"`
+	MimeType        *string `json:"mime_type,omitempty"`
 }
 
 type UploadDocumentParams struct {
@@ -21,3 +39,56 @@ type UploadDocumentParams struct {
 }
 
 type UploadDocumentResponse struct{}
+
+// AccountDocument is a document generated for an account, such as a statement or a trade confirmation.
+type AccountDocument struct {
+	ID      uuid.UUID           `json:"id"`
+	Name    string              `json:"name"`
+	Type    AccountDocumentType `json:"type"`
+	SubType *string             `json:"sub_type"`
+	// Date is the date of the document in YYYY-MM-DD format.
+	Date      string    `json:"date"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type AccountDocumentType string
+
+const (
+	AccountDocumentTypeAccountStatement        AccountDocumentType = "account_statement"
+	AccountDocumentTypeTradeConfirmation       AccountDocumentType = "trade_confirmation"
+	AccountDocumentTypeTradeConfirmationJSON   AccountDocumentType = "trade_confirmation_json"
+	AccountDocumentTypeTaxStatement            AccountDocumentType = "tax_statement"
+	AccountDocumentTypeAccountApplication      AccountDocumentType = "account_application"
+	AccountDocumentTypeTax1099BDetails         AccountDocumentType = "tax_1099_b_details"
+	AccountDocumentTypeTax1099BForm            AccountDocumentType = "tax_1099_b_form"
+	AccountDocumentTypeTax1099DivDetails       AccountDocumentType = "tax_1099_div_details"
+	AccountDocumentTypeTax1099DivForm          AccountDocumentType = "tax_1099_div_form"
+	AccountDocumentTypeTax1099IntDetails       AccountDocumentType = "tax_1099_int_details"
+	AccountDocumentTypeTax1099IntForm          AccountDocumentType = "tax_1099_int_form"
+	AccountDocumentTypeTaxW8                   AccountDocumentType = "tax_w8"
+	AccountDocumentTypeLimitedTradingAuthority AccountDocumentType = "limited_trading_authorization"
+)
+
+type ListDocumentsParams struct {
+	AccountID string               `path:"account_id"`
+	Type      *AccountDocumentType `query:"type,omitempty"`
+	// Start and End are dates in YYYY-MM-DD format.
+	Start *string `query:"start,omitempty"`
+	End   *string `query:"end,omitempty"`
+}
+
+type ListDocumentsResponse = []AccountDocument
+
+type DownloadDocumentParams struct {
+	AccountID  string `path:"account_id"`
+	DocumentID string `path:"document_id"`
+}
+
+// UploadDocumentStreamRequest uploads a document read from Content. The content is base64 encoded while it is sent.
+type UploadDocumentStreamRequest struct {
+	DocumentType    string
+	DocumentSubType *string
+	Content         io.Reader
+	// MimeType is detected from the content if it is not set.
+	MimeType *string
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type WatchParams struct {
@@ -17,6 +18,35 @@ type WatchParams struct {
 	SinceID string `query:"since_id,omitempty"`
 	// UntilID is a string field that is used to specify the ID until which to watch events.
 	UntilID string `query:"until_id,omitempty"`
+	// SinceULID is a string field that is used to specify the ULID from which to start watching events.
+	SinceULID string `query:"since_ulid,omitempty"`
+	// UntilULID is a string field that is used to specify the ULID until which to watch events.
+	UntilULID string `query:"until_ulid,omitempty"`
+}
+
+// ResumableParams is implemented by stream params that can be rewritten to resume a stream
+// after the last delivered event.
+type ResumableParams interface {
+	// Resume returns a copy of the params starting after the event with the given ID or ULID.
+	Resume(id, ulid string) any
+}
+
+// Resume returns a copy of the params that starts watching after the given event.
+// The ULID is preferred over the sequential ID when both are known.
+func (p WatchParams) Resume(id, ulid string) any {
+	switch {
+	case ulid != "":
+		p.SinceULID = ulid
+		p.SinceID = ""
+	case id != "":
+		p.SinceID = id
+		p.SinceULID = ""
+	default:
+		return p
+	}
+	// Since is mutually exclusive with the ID based filters.
+	p.Since = ""
+	return p
 }
 
 // AccountStatusUpdateEvent represents an account status event.
@@ -136,3 +166,36 @@ const (
 	// TransferStatusReturned represents a bank issued ACH return for the transfer.
 	TransferStatusReturned TransferStatus = "RETURNED"
 )
+
+// JournalStatusUpdateEvent represents a journal status update.
+type JournalStatusUpdateEvent struct {
+	EventID    int              `json:"event_id"`
+	EventULID  string           `json:"event_ulid"`
+	JournalID  uuid.UUID        `json:"journal_id"`
+	EntryType  JournalEntryType `json:"entry_type"`
+	StatusFrom JournalStatus    `json:"status_from"`
+	StatusTo   JournalStatus    `json:"status_to"`
+	Timestamp  time.Time        `json:"at"`
+}
+
+// NonTradeActivityEvent represents a non-trade activity such as a dividend, a fee or a cash movement.
+type NonTradeActivityEvent struct {
+	EventID   int          `json:"event_id"`
+	EventULID string       `json:"event_ulid"`
+	ID        string       `json:"id"`
+	AccountID uuid.UUID    `json:"account_id"`
+	EntryType ActivityType `json:"entry_type"`
+	Status    string       `json:"status"`
+	Symbol    *string      `json:"symbol"`
+	// Quantity and Price are only set for activities involving shares.
+	Quantity       *decimal.Decimal `json:"qty"`
+	Price          *decimal.Decimal `json:"price"`
+	PerShareAmount *decimal.Decimal `json:"per_share_amount"`
+	NetAmount      decimal.Decimal  `json:"net_amount"`
+	Description    string           `json:"description"`
+	GroupID        *string          `json:"group_id"`
+	// SettleDate and SystemDate are dates in YYYY-MM-DD format.
+	SettleDate string    `json:"settle_date"`
+	SystemDate string    `json:"system_date"`
+	Timestamp  time.Time `json:"at"`
+}
@@ -0,0 +1,133 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Journal represents a movement of cash (JNLC) or securities (JNLS) between two accounts.
+type Journal struct {
+	ID          uuid.UUID        `json:"id"`
+	EntryType   JournalEntryType `json:"entry_type"`
+	FromAccount uuid.UUID        `json:"from_account"`
+	ToAccount   uuid.UUID        `json:"to_account"`
+	Status      JournalStatus    `json:"status"`
+	// NetAmount is only set for cash journals.
+	NetAmount *decimal.Decimal `json:"net_amount"`
+	// Symbol, Quantity and Price are only set for security journals.
+	Symbol      *string          `json:"symbol"`
+	Quantity    *decimal.Decimal `json:"qty"`
+	Price       *decimal.Decimal `json:"price"`
+	Currency    *string          `json:"currency"`
+	Description *string          `json:"description"`
+	// SettleDate is the settlement date in YYYY-MM-DD format.
+	SettleDate *string `json:"settle_date"`
+	// SystemDate is the date the journal was booked in YYYY-MM-DD format.
+	SystemDate *string `json:"system_date"`
+	// ErrorMessage is set on the entries of a batch journal that failed.
+	ErrorMessage *string `json:"error_message,omitempty"`
+
+	TransmitterName                 *string `json:"transmitter_name,omitempty"`
+	TransmitterAccountNumber        *string `json:"transmitter_account_number,omitempty"`
+	TransmitterAddress              *string `json:"transmitter_address,omitempty"`
+	TransmitterFinancialInstitution *string `json:"transmitter_financial_institution,omitempty"`
+	TransmitterTimestamp            *string `json:"transmitter_timestamp,omitempty"`
+}
+
+type JournalEntryType string
+
+const (
+	// JournalEntryTypeCash moves cash between accounts.
+	JournalEntryTypeCash JournalEntryType = "JNLC"
+	// JournalEntryTypeSecurity moves shares between accounts.
+	JournalEntryTypeSecurity JournalEntryType = "JNLS"
+)
+
+type JournalStatus string
+
+const (
+	// JournalStatusQueued represents a journal that is in queue to be processed.
+	JournalStatusQueued JournalStatus = "queued"
+	// JournalStatusSentToClearing represents a journal that is being processed by the clearing firm.
+	JournalStatusSentToClearing JournalStatus = "sent_to_clearing"
+	// JournalStatusPending represents a journal that is pending processing.
+	JournalStatusPending JournalStatus = "pending"
+	// JournalStatusExecuted represents a journal that is executed.
+	JournalStatusExecuted JournalStatus = "executed"
+	// JournalStatusRejected represents a journal that is rejected.
+	JournalStatusRejected JournalStatus = "rejected"
+	// JournalStatusCanceled represents a journal that is canceled.
+	JournalStatusCanceled JournalStatus = "canceled"
+	// JournalStatusRefused represents a journal that is refused by the clearing firm.
+	JournalStatusRefused JournalStatus = "refused"
+	// JournalStatusCorrect represents a journal that has been corrected.
+	JournalStatusCorrect JournalStatus = "correct"
+	// JournalStatusDeleted represents a pending journal that has been deleted.
+	JournalStatusDeleted JournalStatus = "deleted"
+)
+
+// CreateJournalRequest creates a cash journal (with Amount) or a security journal (with Symbol and Quantity).
+type CreateJournalRequest struct {
+	EntryType   JournalEntryType `json:"entry_type"`
+	FromAccount uuid.UUID        `json:"from_account"`
+	ToAccount   uuid.UUID        `json:"to_account"`
+	Amount      *decimal.Decimal `json:"amount,omitempty"`
+	Symbol      *string          `json:"symbol,omitempty"`
+	Quantity    *decimal.Decimal `json:"qty,omitempty"`
+	Currency    *string          `json:"currency,omitempty"`
+	Description *string          `json:"description,omitempty"`
+
+	TransmitterName                 *string `json:"transmitter_name,omitempty"`
+	TransmitterAccountNumber        *string `json:"transmitter_account_number,omitempty"`
+	TransmitterAddress              *string `json:"transmitter_address,omitempty"`
+	TransmitterFinancialInstitution *string `json:"transmitter_financial_institution,omitempty"`
+	TransmitterTimestamp            *string `json:"transmitter_timestamp,omitempty"`
+}
+
+type CreateJournalResponse struct {
+	Journal
+}
+
+// CreateBatchJournalRequest creates cash journals from a single account to many accounts.
+type CreateBatchJournalRequest struct {
+	EntryType   JournalEntryType    `json:"entry_type"`
+	FromAccount uuid.UUID           `json:"from_account"`
+	Entries     []BatchJournalEntry `json:"entries"`
+}
+
+type BatchJournalEntry struct {
+	ToAccount   uuid.UUID       `json:"to_account"`
+	Amount      decimal.Decimal `json:"amount"`
+	Description *string         `json:"description,omitempty"`
+}
+
+// CreateReverseBatchJournalRequest creates cash journals from many accounts to a single account.
+type CreateReverseBatchJournalRequest struct {
+	EntryType JournalEntryType           `json:"entry_type"`
+	ToAccount uuid.UUID                  `json:"to_account"`
+	Entries   []ReverseBatchJournalEntry `json:"entries"`
+}
+
+type ReverseBatchJournalEntry struct {
+	FromAccount uuid.UUID       `json:"from_account"`
+	Amount      decimal.Decimal `json:"amount"`
+	Description *string         `json:"description,omitempty"`
+}
+
+type CreateBatchJournalResponse = []Journal
+
+type ListJournalsParams struct {
+	// After and Before are dates in YYYY-MM-DD format.
+	After       *string           `query:"after,omitempty"`
+	Before      *string           `query:"before,omitempty"`
+	Status      *JournalStatus    `query:"status,omitempty"`
+	EntryType   *JournalEntryType `query:"entry_type,omitempty"`
+	ToAccount   *string           `query:"to_account,omitempty"`
+	FromAccount *string           `query:"from_account,omitempty"`
+}
+
+type ListJournalsResponse = []Journal
+
+type DeleteJournalParams struct {
+	JournalID string `path:"journal_id"`
+}
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var two = decimal.NewFromInt(2)
+
+// IsValid reports whether the quote has both sides and is not crossed.
+func (q Quote) IsValid() bool {
+	return q.BidPrice.IsPositive() && q.AskPrice.IsPositive() && q.AskPrice.GreaterThanOrEqual(q.BidPrice)
+}
+
+// Spread returns the difference between the ask and bid prices.
+func (q Quote) Spread() decimal.Decimal {
+	return q.AskPrice.Sub(q.BidPrice)
+}
+
+// MidPrice returns the average of the ask and bid prices.
+func (q Quote) MidPrice() decimal.Decimal {
+	return q.AskPrice.Add(q.BidPrice).Div(two)
+}
+
+// NBBOPoint is a point of the NBBO series of a symbol.
+type NBBOPoint struct {
+	Timestamp time.Time
+	BidPrice  decimal.Decimal
+	AskPrice  decimal.Decimal
+	Spread    decimal.Decimal
+	MidPrice  decimal.Decimal
+	// TimeWeightedSpread is the average spread since the first point, weighted by how long each quote was in force.
+	// It equals the spread of the first point until time has elapsed.
+	TimeWeightedSpread decimal.Decimal
+}
+
+// NBBOSeries turns the quotes of a single symbol into spread, mid-price and time-weighted spread series.
+// Quotes must be added in chronological order. Quotes with a missing side or a crossed market are skipped.
+type NBBOSeries struct {
+	Points []NBBOPoint
+
+	// weightedSpread is the sum of the spreads multiplied by the nanoseconds they were in force.
+	weightedSpread decimal.Decimal
+	elapsed        time.Duration
+}
+
+// NewNBBOSeries returns a series built from the given quotes.
+func NewNBBOSeries(quotes ...Quote) *NBBOSeries {
+	s := &NBBOSeries{}
+	for _, q := range quotes {
+		s.Add(q)
+	}
+	return s
+}
+
+// Add appends a quote to the series. It reports whether the quote was used.
+func (s *NBBOSeries) Add(q Quote) bool {
+	if !q.IsValid() {
+		return false
+	}
+	if n := len(s.Points); n > 0 {
+		last := s.Points[n-1]
+		d := q.Timestamp.Sub(last.Timestamp)
+		if d < 0 {
+			return false
+		}
+		s.weightedSpread = s.weightedSpread.Add(last.Spread.Mul(decimal.NewFromInt(int64(d))))
+		s.elapsed += d
+	}
+
+	p := NBBOPoint{
+		Timestamp: q.Timestamp,
+		BidPrice:  q.BidPrice,
+		AskPrice:  q.AskPrice,
+		Spread:    q.Spread(),
+		MidPrice:  q.MidPrice(),
+	}
+	p.TimeWeightedSpread = s.timeWeightedSpread(p.Spread)
+	s.Points = append(s.Points, p)
+	return true
+}
+
+// TimeWeightedSpread returns the time-weighted spread up to the last quote of the series.
+func (s *NBBOSeries) TimeWeightedSpread() decimal.Decimal {
+	if len(s.Points) == 0 {
+		return decimal.Zero
+	}
+	return s.Points[len(s.Points)-1].TimeWeightedSpread
+}
+
+// TimeWeightedSpreadUntil returns the time-weighted spread with the last quote in force until the given time.
+func (s *NBBOSeries) TimeWeightedSpreadUntil(until time.Time) decimal.Decimal {
+	n := len(s.Points)
+	if n == 0 {
+		return decimal.Zero
+	}
+	last := s.Points[n-1]
+	d := until.Sub(last.Timestamp)
+	if d <= 0 {
+		return last.TimeWeightedSpread
+	}
+	weighted := s.weightedSpread.Add(last.Spread.Mul(decimal.NewFromInt(int64(d))))
+	return weighted.Div(decimal.NewFromInt(int64(s.elapsed + d)))
+}
+
+func (s *NBBOSeries) timeWeightedSpread(current decimal.Decimal) decimal.Decimal {
+	if s.elapsed == 0 {
+		return current
+	}
+	return s.weightedSpread.Div(decimal.NewFromInt(int64(s.elapsed)))
+}
@@ -0,0 +1,277 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrInvalidOptionSymbol is returned when a symbol is not an OCC option symbol.
+var ErrInvalidOptionSymbol = errors.New("invalid option symbol")
+
+const (
+	// occDateLayout is the layout of the expiration date in an OCC option symbol.
+	occDateLayout = "060102"
+	// occSuffixLength is the length of the expiration date, type and strike that follow the root.
+	occSuffixLength = 15
+	// occMaxRootLength is the maximum length of the root of an OCC option symbol.
+	occMaxRootLength = 6
+)
+
+type OptionType string
+
+const (
+	OptionTypeCall OptionType = "call"
+	OptionTypePut  OptionType = "put"
+)
+
+// OptionSymbol is the decomposition of an OCC option symbol, such as AAPL240621C00190000.
+type OptionSymbol struct {
+	// Root is the root symbol, which is the underlying symbol unless the contract has been adjusted.
+	Root string
+	// Expiration is the expiration date in YYYY-MM-DD format.
+	Expiration string
+	Type       OptionType
+	Strike     decimal.Decimal
+}
+
+// ParseOptionSymbol parses an OCC option symbol.
+// The root may be padded with spaces to six characters, as in the OSI format.
+func ParseOptionSymbol(symbol string) (OptionSymbol, error) {
+	if len(symbol) <= occSuffixLength {
+		return OptionSymbol{}, fmt.Errorf("%w: %q", ErrInvalidOptionSymbol, symbol)
+	}
+	split := len(symbol) - occSuffixLength
+	root := strings.TrimRight(symbol[:split], " ")
+	suffix := symbol[split:]
+	if root == "" || len(root) > occMaxRootLength || strings.Contains(root, " ") {
+		return OptionSymbol{}, fmt.Errorf("%w: %q: invalid root", ErrInvalidOptionSymbol, symbol)
+	}
+
+	expiration, err := time.Parse(occDateLayout, suffix[:6])
+	if err != nil {
+		return OptionSymbol{}, fmt.Errorf("%w: %q: invalid expiration date", ErrInvalidOptionSymbol, symbol)
+	}
+
+	var optionType OptionType
+	switch suffix[6] {
+	case 'C':
+		optionType = OptionTypeCall
+	case 'P':
+		optionType = OptionTypePut
+	default:
+		return OptionSymbol{}, fmt.Errorf("%w: %q: invalid type", ErrInvalidOptionSymbol, symbol)
+	}
+
+	strike, err := strconv.ParseUint(suffix[7:], 10, 64)
+	if err != nil {
+		return OptionSymbol{}, fmt.Errorf("%w: %q: invalid strike", ErrInvalidOptionSymbol, symbol)
+	}
+
+	return OptionSymbol{
+		Root:       root,
+		Expiration: expiration.Format(time.DateOnly),
+		Type:       optionType,
+		// The strike is given in thousandths of a dollar.
+		Strike: decimal.New(int64(strike), -3),
+	}, nil
+}
+
+// String formats the option symbol as an OCC option symbol without padding.
+// It returns an empty string if the symbol cannot be represented, see Validate.
+func (s OptionSymbol) String() string {
+	if s.Validate() != nil {
+		return ""
+	}
+	expiration, _ := time.Parse(time.DateOnly, s.Expiration)
+	optionType := "C"
+	if s.Type == OptionTypePut {
+		optionType = "P"
+	}
+	return fmt.Sprintf("%s%s%s%08d", s.Root, expiration.Format(occDateLayout), optionType, s.Strike.Shift(3).IntPart())
+}
+
+// Validate reports whether the option symbol can be formatted as an OCC option symbol.
+func (s OptionSymbol) Validate() error {
+	if s.Root == "" || len(s.Root) > occMaxRootLength || strings.Contains(s.Root, " ") {
+		return fmt.Errorf("%w: invalid root %q", ErrInvalidOptionSymbol, s.Root)
+	}
+	if _, err := time.Parse(time.DateOnly, s.Expiration); err != nil {
+		return fmt.Errorf("%w: invalid expiration date %q", ErrInvalidOptionSymbol, s.Expiration)
+	}
+	if s.Type != OptionTypeCall && s.Type != OptionTypePut {
+		return fmt.Errorf("%w: invalid type %q", ErrInvalidOptionSymbol, s.Type)
+	}
+	thousandths := s.Strike.Shift(3)
+	if s.Strike.IsNegative() || !thousandths.IsInteger() || thousandths.GreaterThanOrEqual(decimal.New(1, 8)) {
+		return fmt.Errorf("%w: invalid strike %s", ErrInvalidOptionSymbol, s.Strike)
+	}
+	return nil
+}
+
+// OptionQuote is an option quote.
+// It is not a Quote because option quotes carry a single condition and no tape.
+type OptionQuote struct {
+	AskPrice    decimal.Decimal `json:"ap"`
+	AskSize     uint64          `json:"as"`
+	AskExchange string          `json:"ax"`
+	BidPrice    decimal.Decimal `json:"bp"`
+	BidSize     uint64          `json:"bs"`
+	BidExchange string          `json:"bx"`
+	Condition   string          `json:"c"`
+	Timestamp   time.Time       `json:"t"`
+}
+
+// OptionTrade is an option trade.
+// It is not a Trade because option trades carry a single condition and no ID or tape.
+type OptionTrade struct {
+	Price     decimal.Decimal `json:"p"`
+	Size      uint64          `json:"s"`
+	Exchange  string          `json:"x"`
+	Condition string          `json:"c"`
+	Timestamp time.Time       `json:"t"`
+}
+
+// SymbolOptionTrade is an option trade along with the contract symbol it was reported for.
+type SymbolOptionTrade struct {
+	Symbol string
+	OptionTrade
+}
+
+// Greeks are the sensitivities of the price of an option contract.
+type Greeks struct {
+	Delta decimal.Decimal `json:"delta"`
+	Gamma decimal.Decimal `json:"gamma"`
+	Rho   decimal.Decimal `json:"rho"`
+	Theta decimal.Decimal `json:"theta"`
+	Vega  decimal.Decimal `json:"vega"`
+}
+
+// OptionSnapshot is the latest trade, quote and bars of an option contract.
+// Greeks and ImpliedVolatility are not set for contracts that could not be priced.
+type OptionSnapshot struct {
+	LatestTrade       OptionTrade      `json:"latestTrade"`
+	LatestQuote       OptionQuote      `json:"latestQuote"`
+	MinBar            Bar              `json:"minuteBar"`
+	DayBar            Bar              `json:"dailyBar"`
+	PreviousDayBar    Bar              `json:"prevDailyBar"`
+	Greeks            *Greeks          `json:"greeks"`
+	ImpliedVolatility *decimal.Decimal `json:"impliedVolatility"`
+}
+
+// OptionChain maps the option contract symbols of an underlying to their snapshots.
+type OptionChain map[string]OptionSnapshot
+
+// OptionChainFilter selects the contracts of an option chain. Unset fields match all contracts.
+type OptionChainFilter struct {
+	Type *OptionType
+	// ExpirationFrom and ExpirationTo are inclusive dates in YYYY-MM-DD format.
+	ExpirationFrom *string
+	ExpirationTo   *string
+	// StrikeFrom and StrikeTo are inclusive.
+	StrikeFrom *decimal.Decimal
+	StrikeTo   *decimal.Decimal
+}
+
+// Match reports whether the contract matches the filter.
+func (f OptionChainFilter) Match(symbol OptionSymbol) bool {
+	switch {
+	case f.Type != nil && symbol.Type != *f.Type,
+		// Dates in YYYY-MM-DD format sort lexically.
+		f.ExpirationFrom != nil && symbol.Expiration < *f.ExpirationFrom,
+		f.ExpirationTo != nil && symbol.Expiration > *f.ExpirationTo,
+		f.StrikeFrom != nil && symbol.Strike.LessThan(*f.StrikeFrom),
+		f.StrikeTo != nil && symbol.Strike.GreaterThan(*f.StrikeTo):
+		return false
+	default:
+		return true
+	}
+}
+
+// Filter returns the contracts of the chain that match the filter.
+// Contracts whose symbol cannot be parsed are left out.
+func (c OptionChain) Filter(filter OptionChainFilter) OptionChain {
+	res := OptionChain{}
+	for symbol, snapshot := range c {
+		parsed, err := ParseOptionSymbol(symbol)
+		if err != nil || !filter.Match(parsed) {
+			continue
+		}
+		res[symbol] = snapshot
+	}
+	return res
+}
+
+type GetOptionChainParams struct {
+	UnderlyingSymbol string `path:"underlying_symbol"`
+	// Feed is either "opra" or "indicative".
+	Feed *string     `query:"feed,omitempty"`
+	Type *OptionType `query:"type,omitempty"`
+	// ExpirationDate, ExpirationDateGte and ExpirationDateLte are dates in YYYY-MM-DD format.
+	ExpirationDate    *string          `query:"expiration_date,omitempty"`
+	ExpirationDateGte *string          `query:"expiration_date_gte,omitempty"`
+	ExpirationDateLte *string          `query:"expiration_date_lte,omitempty"`
+	StrikePriceGte    *decimal.Decimal `query:"strike_price_gte,omitempty"`
+	StrikePriceLte    *decimal.Decimal `query:"strike_price_lte,omitempty"`
+	RootSymbol        *string          `query:"root_symbol,omitempty"`
+	UpdatedSince      *time.Time       `query:"updated_since,omitempty"`
+	Limit             *int             `query:"limit,omitempty"`
+	PageToken         *string          `query:"page_token,omitempty"`
+}
+
+type GetOptionChainResponse struct {
+	Snapshots     OptionChain `json:"snapshots"`
+	NextPageToken string      `json:"next_page_token"`
+}
+
+type GetOptionBarsParams struct {
+	// Symbols is a comma-separated list of option contract symbols.
+	Symbols   string     `query:"symbols,required"`
+	Timeframe string     `query:"timeframe,required"`
+	Start     *time.Time `query:"start,omitempty"`
+	End       *time.Time `query:"end,omitempty"`
+	Limit     *int       `query:"limit,omitempty"`
+	Sort      *string    `query:"sort,omitempty"`
+	PageToken *string    `query:"page_token,omitempty"`
+}
+
+type GetOptionBarsResponse struct {
+	Bars          HistoricalBarsAggregate `json:"bars"`
+	NextPageToken string                  `json:"next_page_token"`
+}
+
+type GetOptionTradesParams struct {
+	Symbols   string     `query:"symbols,required"`
+	Start     *time.Time `query:"start,omitempty"`
+	End       *time.Time `query:"end,omitempty"`
+	Limit     *int       `query:"limit,omitempty"`
+	Sort      *string    `query:"sort,omitempty"`
+	PageToken *string    `query:"page_token,omitempty"`
+}
+
+type GetOptionTradesResponse struct {
+	Trades        map[string][]OptionTrade `json:"trades"`
+	NextPageToken string                   `json:"next_page_token"`
+}
+
+type GetLatestOptionQuotesParams struct {
+	Symbols string  `query:"symbols,required"`
+	Feed    *string `query:"feed,omitempty"`
+}
+
+type GetLatestOptionQuotesResponse struct {
+	Quotes map[string]OptionQuote `json:"quotes"`
+}
+
+type GetLatestOptionTradesParams struct {
+	Symbols string  `query:"symbols,required"`
+	Feed    *string `query:"feed,omitempty"`
+}
+
+type GetLatestOptionTradesResponse struct {
+	Trades map[string]OptionTrade `json:"trades"`
+}
@@ -0,0 +1,138 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// OptionContract represents a tradable option contract.
+type OptionContract struct {
+	ID       uuid.UUID            `json:"id"`
+	Symbol   string               `json:"symbol"`
+	Name     string               `json:"name"`
+	Status   OptionContractStatus `json:"status"`
+	Tradable bool                 `json:"tradable"`
+	// ExpirationDate is the expiration date in YYYY-MM-DD format.
+	ExpirationDate    string           `json:"expiration_date"`
+	RootSymbol        string           `json:"root_symbol"`
+	UnderlyingSymbol  string           `json:"underlying_symbol"`
+	UnderlyingAssetID uuid.UUID        `json:"underlying_asset_id"`
+	Type              OptionType       `json:"type"`
+	Style             OptionStyle      `json:"style"`
+	StrikePrice       decimal.Decimal  `json:"strike_price"`
+	Multiplier        decimal.Decimal  `json:"multiplier"`
+	Size              decimal.Decimal  `json:"size"`
+	OpenInterest      *decimal.Decimal `json:"open_interest"`
+	// OpenInterestDate and ClosePriceDate are dates in YYYY-MM-DD format.
+	OpenInterestDate *string          `json:"open_interest_date"`
+	ClosePrice       *decimal.Decimal `json:"close_price"`
+	ClosePriceDate   *string          `json:"close_price_date"`
+	// Deliverables are only set when requested with ShowDeliverables.
+	Deliverables []OptionDeliverable `json:"deliverables,omitempty"`
+}
+
+type OptionContractStatus string
+
+const (
+	OptionContractStatusActive   OptionContractStatus = "active"
+	OptionContractStatusInactive OptionContractStatus = "inactive"
+)
+
+type OptionStyle string
+
+const (
+	OptionStyleAmerican OptionStyle = "american"
+	OptionStyleEuropean OptionStyle = "european"
+)
+
+// OptionDeliverable is the cash or the shares delivered when an option contract is exercised.
+type OptionDeliverable struct {
+	Type                 string           `json:"type"`
+	Symbol               string           `json:"symbol"`
+	AssetID              *uuid.UUID       `json:"asset_id"`
+	Amount               *decimal.Decimal `json:"amount"`
+	AllocationPercentage decimal.Decimal  `json:"allocation_percentage"`
+	SettlementType       string           `json:"settlement_type"`
+	SettlementMethod     string           `json:"settlement_method"`
+	DelayedSettlement    bool             `json:"delayed_settlement"`
+}
+
+type ListOptionContractsParams struct {
+	// UnderlyingSymbols is a comma-separated list of underlying symbols.
+	UnderlyingSymbols *string               `query:"underlying_symbols,omitempty"`
+	ShowDeliverables  *bool                 `query:"show_deliverables,omitempty"`
+	Status            *OptionContractStatus `query:"status,omitempty"`
+	// ExpirationDate, ExpirationDateGte and ExpirationDateLte are dates in YYYY-MM-DD format.
+	ExpirationDate    *string          `query:"expiration_date,omitempty"`
+	ExpirationDateGte *string          `query:"expiration_date_gte,omitempty"`
+	ExpirationDateLte *string          `query:"expiration_date_lte,omitempty"`
+	RootSymbol        *string          `query:"root_symbol,omitempty"`
+	Type              *OptionType      `query:"type,omitempty"`
+	Style             *OptionStyle     `query:"style,omitempty"`
+	StrikePriceGte    *decimal.Decimal `query:"strike_price_gte,omitempty"`
+	StrikePriceLte    *decimal.Decimal `query:"strike_price_lte,omitempty"`
+	Limit             *int             `query:"limit,omitempty"`
+	PageToken         *string          `query:"page_token,omitempty"`
+}
+
+type ListOptionContractsResponse struct {
+	OptionContracts []OptionContract `json:"option_contracts"`
+	NextPageToken   *string          `json:"next_page_token"`
+}
+
+type GetOptionContractParams struct {
+	// SymbolOrID is the OCC symbol or the ID of the contract.
+	SymbolOrID string `path:"symbol_or_id"`
+}
+
+type GetOptionContractResponse struct {
+	OptionContract
+}
+
+type ExerciseOptionPositionParams struct {
+	AccountID string `path:"account_id"`
+	// SymbolOrContractID is the OCC symbol or the contract ID of the position to exercise.
+	SymbolOrContractID string `path:"symbol_or_contract_id" validate:"required"`
+}
+
+// OptionsApproval represents a request of an account to be approved for an options trading level.
+type OptionsApproval struct {
+	ID             uuid.UUID             `json:"id"`
+	AccountID      uuid.UUID             `json:"account_id"`
+	RequestedLevel int                   `json:"requested_level"`
+	Status         OptionsApprovalStatus `json:"status"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+type OptionsApprovalStatus string
+
+const (
+	OptionsApprovalStatusPending  OptionsApprovalStatus = "PENDING"
+	OptionsApprovalStatusApproved OptionsApprovalStatus = "APPROVED"
+	OptionsApprovalStatusRejected OptionsApprovalStatus = "REJECTED"
+)
+
+type CreateOptionsApprovalParams struct {
+	AccountID string `path:"account_id"`
+}
+
+// CreateOptionsApprovalRequest requests an options trading level. Once approved, the level is reflected in
+// the OptionsApprovedLevel of the account.
+type CreateOptionsApprovalRequest struct {
+	// Level is the requested options trading level, from 1 (covered calls and cash-secured puts) to 3 (spreads).
+	Level int `json:"level"`
+}
+
+type CreateOptionsApprovalResponse struct {
+	OptionsApproval
+}
+
+type ListOptionsApprovalsParams struct {
+	AccountID *string                `query:"account_id,omitempty"`
+	Status    *OptionsApprovalStatus `query:"status,omitempty"`
+}
+
+type ListOptionsApprovalsResponse = []OptionsApproval
@@ -0,0 +1,189 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestParseOptionSymbol(t *testing.T) {
+	tests := []struct {
+		name   string
+		symbol string
+		want   OptionSymbol
+		// formatted is the expected String of the parsed symbol, which has no padding.
+		formatted string
+	}{
+		{
+			name:      "standard root",
+			symbol:    "AAPL240621C00190000",
+			want:      OptionSymbol{Root: "AAPL", Expiration: "2024-06-21", Type: OptionTypeCall, Strike: decimal.NewFromInt(190)},
+			formatted: "AAPL240621C00190000",
+		},
+		{
+			name:      "padded root",
+			symbol:    "SPXW  240621P05000000",
+			want:      OptionSymbol{Root: "SPXW", Expiration: "2024-06-21", Type: OptionTypePut, Strike: decimal.NewFromInt(5000)},
+			formatted: "SPXW240621P05000000",
+		},
+		{
+			name:      "root with a dot",
+			symbol:    "BRK.B250117C00412500",
+			want:      OptionSymbol{Root: "BRK.B", Expiration: "2025-01-17", Type: OptionTypeCall, Strike: decimal.RequireFromString("412.5")},
+			formatted: "BRK.B250117C00412500",
+		},
+		{
+			name:      "six character root and fractional strike",
+			symbol:    "GOOGL1241220P00000500",
+			want:      OptionSymbol{Root: "GOOGL1", Expiration: "2024-12-20", Type: OptionTypePut, Strike: decimal.RequireFromString("0.5")},
+			formatted: "GOOGL1241220P00000500",
+		},
+		{
+			name:      "largest strike",
+			symbol:    "SPX240621C99999999",
+			want:      OptionSymbol{Root: "SPX", Expiration: "2024-06-21", Type: OptionTypeCall, Strike: decimal.RequireFromString("99999.999")},
+			formatted: "SPX240621C99999999",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseOptionSymbol(tt.symbol)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Root != tt.want.Root || got.Expiration != tt.want.Expiration || got.Type != tt.want.Type || !got.Strike.Equal(tt.want.Strike) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+			if s := got.String(); s != tt.formatted {
+				t.Errorf("String() = %q, want %q", s, tt.formatted)
+			}
+			again, err := ParseOptionSymbol(got.String())
+			if err != nil {
+				t.Fatalf("parsing formatted symbol: %v", err)
+			}
+			if again.String() != got.String() {
+				t.Errorf("round trip: got %q, want %q", again.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParseOptionSymbolErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		symbol string
+	}{
+		{name: "empty", symbol: ""},
+		{name: "stock symbol", symbol: "AAPL"},
+		{name: "missing root", symbol: "240621C00190000"},
+		{name: "padded without root", symbol: "  240621C00190000"},
+		{name: "root too long", symbol: "ABCDEFG240621C00190000"},
+		{name: "space in root", symbol: "BRK B240621C00190000"},
+		{name: "invalid date", symbol: "AAPL240230C00190000"},
+		{name: "invalid month", symbol: "AAPL241321C00190000"},
+		{name: "invalid type", symbol: "AAPL240621X00190000"},
+		{name: "non-numeric strike", symbol: "AAPL240621C0019000A"},
+		{name: "signed strike", symbol: "AAPL240621C-0190000"},
+		{name: "short strike", symbol: "AAPL240621C0019000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := ParseOptionSymbol(tt.symbol); !errors.Is(err, ErrInvalidOptionSymbol) {
+				t.Errorf("got %+v, %v, want ErrInvalidOptionSymbol", got, err)
+			}
+		})
+	}
+}
+
+func TestOptionSymbolValidate(t *testing.T) {
+	valid := OptionSymbol{Root: "AAPL", Expiration: "2024-06-21", Type: OptionTypeCall, Strike: decimal.NewFromInt(190)}
+	tests := []struct {
+		name   string
+		modify func(s *OptionSymbol)
+	}{
+		{name: "empty root", modify: func(s *OptionSymbol) { s.Root = "" }},
+		{name: "root too long", modify: func(s *OptionSymbol) { s.Root = "ABCDEFG" }},
+		{name: "invalid expiration", modify: func(s *OptionSymbol) { s.Expiration = "2024-02-30" }},
+		{name: "invalid type", modify: func(s *OptionSymbol) { s.Type = "straddle" }},
+		{name: "negative strike", modify: func(s *OptionSymbol) { s.Strike = decimal.NewFromInt(-1) }},
+		{name: "strike below a thousandth", modify: func(s *OptionSymbol) { s.Strike = decimal.RequireFromString("1.0005") }},
+		{name: "strike too large", modify: func(s *OptionSymbol) { s.Strike = decimal.NewFromInt(100000) }},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("valid symbol: unexpected error: %v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := valid
+			tt.modify(&s)
+			if err := s.Validate(); !errors.Is(err, ErrInvalidOptionSymbol) {
+				t.Errorf("Validate() = %v, want ErrInvalidOptionSymbol", err)
+			}
+			if got := s.String(); got != "" {
+				t.Errorf("String() = %q, want an empty string", got)
+			}
+		})
+	}
+}
+
+func TestOptionChainFilterMatch(t *testing.T) {
+	symbol := OptionSymbol{Root: "AAPL", Expiration: "2024-06-21", Type: OptionTypeCall, Strike: decimal.NewFromInt(190)}
+	date := func(s string) *string { return &s }
+	strike := func(s string) *decimal.Decimal { d := decimal.RequireFromString(s); return &d }
+	optionType := func(t OptionType) *OptionType { return &t }
+
+	tests := []struct {
+		name   string
+		filter OptionChainFilter
+		want   bool
+	}{
+		{name: "empty filter", filter: OptionChainFilter{}, want: true},
+		{name: "same type", filter: OptionChainFilter{Type: optionType(OptionTypeCall)}, want: true},
+		{name: "other type", filter: OptionChainFilter{Type: optionType(OptionTypePut)}, want: false},
+		{name: "expiration from is inclusive", filter: OptionChainFilter{ExpirationFrom: date("2024-06-21")}, want: true},
+		{name: "expiration from the next day", filter: OptionChainFilter{ExpirationFrom: date("2024-06-22")}, want: false},
+		{name: "expiration to is inclusive", filter: OptionChainFilter{ExpirationTo: date("2024-06-21")}, want: true},
+		{name: "expiration to the previous day", filter: OptionChainFilter{ExpirationTo: date("2024-06-20")}, want: false},
+		{name: "strike from is inclusive", filter: OptionChainFilter{StrikeFrom: strike("190.000")}, want: true},
+		{name: "strike from just above", filter: OptionChainFilter{StrikeFrom: strike("190.001")}, want: false},
+		{name: "strike to is inclusive", filter: OptionChainFilter{StrikeTo: strike("190")}, want: true},
+		{name: "strike to just below", filter: OptionChainFilter{StrikeTo: strike("189.999")}, want: false},
+		{
+			name: "all bounds",
+			filter: OptionChainFilter{
+				Type:           optionType(OptionTypeCall),
+				ExpirationFrom: date("2024-06-01"),
+				ExpirationTo:   date("2024-06-30"),
+				StrikeFrom:     strike("180"),
+				StrikeTo:       strike("200"),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Match(symbol); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptionChainFilter(t *testing.T) {
+	chain := OptionChain{
+		"AAPL240621C00190000": {},
+		"AAPL240621P00190000": {},
+		"AAPL240719C00200000": {},
+		"not a symbol":        {},
+	}
+	got := chain.Filter(OptionChainFilter{Type: func(t OptionType) *OptionType { return &t }(OptionTypeCall)})
+	if len(got) != 2 {
+		t.Fatalf("got %d contracts, want 2: %v", len(got), got)
+	}
+	for _, symbol := range []string{"AAPL240621C00190000", "AAPL240719C00200000"} {
+		if _, ok := got[symbol]; !ok {
+			t.Errorf("missing %s", symbol)
+		}
+	}
+}
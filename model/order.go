@@ -1,6 +1,8 @@
 package model
 
 import (
+	"encoding/json"
+	"net/http"
 	"time"
 
 	"github.com/google/uuid"
@@ -30,7 +32,7 @@ import (
 // | `qty`              | string/number    | Ordered quantity. If entered, `notional` will be null. Can take up to 9 decimal points.                                                                                               |
 // | `filled_qty`       | string/number    | Filled quantity                                                                                                                                                                       |
 // | `filled_avg_price` | string/number    | Filled average price. Can be 0 until order is processed in case order is passed outside of market hours                                                                               |
-// | `order_class`      | string           | Valid values: `simple`, `bracket`, `oco` or `oto`                                                                                                                                     |
+// | `order_class`      | string           | Valid values: `simple`, `bracket`, `oco`, `oto` or `mleg`                                                                                                                             |
 // | `order_type`       | string/number    | (Deprecated with just `type` field below.)                                                                                                                                            |
 // | `type`             | string           | Valid values: `market`, `limit`, `stop`, `stop_limit`, `trailing_stop`                                                                                                                |
 // | `side`             | string           | Valid values: `buy` and `sell`                                                                                                                                                        |
@@ -40,6 +42,8 @@ import (
 // | `status`           | string           | [Enum.OrderStatus]({{< relref "#order-status" >}})                                                                                                                                    |
 // | `extended_hours`   | boolean          | If true, eligible for execution outside regular trading hours.                                                                                                                        |
 // | `legs`             | array            | When querying non-simple order_class orders in a nested style, an array of Order entities associated with this order. Otherwise, null.                                                |
+// | `ratio_qty`        | string/number    | The ratio of a leg of a multi-leg option order to the order quantity.                                                                                                                 |
+// | `position_intent`  | string           | Valid values: `buy_to_open`, `buy_to_close`, `sell_to_open` or `sell_to_close`                                                                                                        |
 // | `trail_percent`    | string/number    | The percent value away from the high water mark for trailing stop orders.                                                                                                             |
 // | `trail_price`      | string/number    | The dollar value away from the high water mark for trailing stop orders.                                                                                                              |
 // | `hwm`              | string/number    | The highest (lowest) market price seen since the trailing stop order was submitted.                                                                                                   |
@@ -66,6 +70,8 @@ type Order struct {
 	StopPrice      *decimal.Decimal `json:"stop_price,omitempty"`
 	ExtendedHours  bool             `json:"extended_hours"`
 	Legs           []Order          `json:"legs"`
+	RatioQuantity  *decimal.Decimal `json:"ratio_qty,omitempty"`
+	PositionIntent *PositionIntent  `json:"position_intent,omitempty"`
 	TrailPercent   *decimal.Decimal `json:"trail_percent,omitempty"`
 	TrailPrice     *decimal.Decimal `json:"trail_price,omitempty"`
 	HWM            *string          `json:"hwm,omitempty"`
@@ -87,10 +93,12 @@ type Order struct {
 }
 
 type CreateOrderRequest struct {
-	Symbol          string           `json:"symbol"`
-	Quantity        *decimal.Decimal `json:"qty"`
-	Notional        *decimal.Decimal `json:"notional,omitempty"`
-	Side            string           `json:"side"`
+	// Symbol is left empty for multi-leg orders, whose symbols are set on the legs.
+	Symbol   string           `json:"symbol,omitempty"`
+	Quantity *decimal.Decimal `json:"qty"`
+	Notional *decimal.Decimal `json:"notional,omitempty"`
+	// Side is left empty for multi-leg orders, whose sides are set on the legs.
+	Side            string           `json:"side,omitempty"`
 	Type            string           `json:"type"`
 	TimeInForce     string           `json:"time_in_force"`
 	LimitPrice      *decimal.Decimal `json:"limit_price,omitempty"`
@@ -102,6 +110,15 @@ type CreateOrderRequest struct {
 	Commission      decimal.Decimal  `json:"commission,omitempty"`
 	CommissionType  *CommissionType  `json:"commission_type,omitempty"`
 	ExtendedHours   bool             `json:"extended_hours"`
+	OrderClass      *OrderClass      `json:"order_class,omitempty"`
+	// Legs are the contracts of a multi-leg option order. Quantity is then the number of strategy units.
+	Legs           []OrderLeg      `json:"legs,omitempty"`
+	PositionIntent *PositionIntent `json:"position_intent,omitempty"`
+}
+
+// IdempotencyKey returns the client order ID, which Alpaca uses to reject duplicate submissions.
+func (r *CreateOrderRequest) IdempotencyKey() string {
+	return r.ClientOrderID
 }
 
 // CommissionType is an enum to select how to interpret the value provided in the commission field.
@@ -122,6 +139,36 @@ const (
 	CommissionTypeBPS      CommissionType = "bps"
 )
 
+type OrderClass string
+
+const (
+	OrderClassSimple  OrderClass = "simple"
+	OrderClassBracket OrderClass = "bracket"
+	OrderClassOCO     OrderClass = "oco"
+	OrderClassOTO     OrderClass = "oto"
+	// OrderClassMultiLeg is a multi-leg option order, such as a spread, whose legs are filled together.
+	OrderClassMultiLeg OrderClass = "mleg"
+)
+
+// PositionIntent tells whether an option order opens or closes a position.
+type PositionIntent string
+
+const (
+	PositionIntentBuyToOpen   PositionIntent = "buy_to_open"
+	PositionIntentBuyToClose  PositionIntent = "buy_to_close"
+	PositionIntentSellToOpen  PositionIntent = "sell_to_open"
+	PositionIntentSellToClose PositionIntent = "sell_to_close"
+)
+
+// OrderLeg is a leg of a multi-leg option order.
+type OrderLeg struct {
+	Symbol string `json:"symbol"`
+	// RatioQuantity is the number of contracts of the leg per strategy unit.
+	RatioQuantity  decimal.Decimal `json:"ratio_qty"`
+	Side           string          `json:"side,omitempty"`
+	PositionIntent *PositionIntent `json:"position_intent,omitempty"`
+}
+
 type TakeProfitPrice struct {
 	LimitPrice decimal.Decimal `json:"limit_price"`
 }
@@ -139,6 +186,58 @@ type CancelOrderParams struct {
 	OrderID   string `path:"order_id"`
 }
 
+type ReplaceOrderParams struct {
+	AccountID string `path:"account_id"`
+	OrderID   string `path:"order_id"`
+}
+
+// ReplaceOrderRequest holds the attributes of an order to replace. Only the fields that are set are sent.
+type ReplaceOrderRequest struct {
+	Quantity      *decimal.Decimal `json:"qty,omitempty"`
+	TimeInForce   *string          `json:"time_in_force,omitempty"`
+	LimitPrice    *decimal.Decimal `json:"limit_price,omitempty"`
+	StopPrice     *decimal.Decimal `json:"stop_price,omitempty"`
+	Trail         *decimal.Decimal `json:"trail,omitempty"`
+	ClientOrderID *string          `json:"client_order_id,omitempty"`
+}
+
+// IdempotencyKey returns the client order ID of the replacing order.
+func (r *ReplaceOrderRequest) IdempotencyKey() string {
+	if r.ClientOrderID == nil {
+		return ""
+	}
+	return *r.ClientOrderID
+}
+
+type ReplaceOrderResponse struct {
+	Order
+}
+
+type CancelAllOrdersParams struct {
+	AccountID string `path:"account_id"`
+}
+
+type CancelAllOrdersResponse = []CancelOrderResult
+
+// CancelOrderResult is the outcome of the cancellation of a single order within a cancel-all request.
+type CancelOrderResult struct {
+	OrderID uuid.UUID `json:"id"`
+	// Status is the HTTP status code of the cancellation of this order.
+	Status int `json:"status"`
+	// Body is the order on success or the error payload on failure.
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// IsSuccess reports whether the order was canceled.
+func (r CancelOrderResult) IsSuccess() bool {
+	return r.Status >= http.StatusOK && r.Status < http.StatusMultipleChoices
+}
+
+type GetOrderByClientOrderIDParams struct {
+	AccountID     string `path:"account_id"`
+	ClientOrderID string `query:"client_order_id" validate:"required"`
+}
+
 type GetOrderParams struct {
 	AccountID string `path:"account_id"`
 	OrderID   string `path:"order_id"`
@@ -150,12 +249,20 @@ type GetOrderResponse struct {
 
 type ListOrdersParams struct {
 	AccountID string    `path:"account_id"`
-	Limit     int       `query:"limit"`
-	After     time.Time `query:"after"`
-	Until     time.Time `query:"until"`
-	Direction string    `query:"direction"`
-	Symbols   []string  `query:"symbols"`
-	Status    string    `query:"status"`
+	Limit     int       `query:"limit,omitempty"`
+	After     time.Time `query:"after,omitempty"`
+	Until     time.Time `query:"until,omitempty"`
+	Direction string    `query:"direction,omitempty"`
+	Symbols   []string  `query:"symbols,omitempty"`
+	Status    string    `query:"status,omitempty"`
 }
 
+const (
+	// ListOrdersMaxLimit is the maximum number of orders returned by a single list call.
+	ListOrdersMaxLimit = 500
+
+	SortDirectionAsc  = "asc"
+	SortDirectionDesc = "desc"
+)
+
 type ListOrdersResponse = []Order
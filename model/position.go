@@ -1,6 +1,11 @@
 package model
 
-import "github.com/shopspring/decimal"
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/shopspring/decimal"
+)
 
 type GetOpenPositionBySymbolParams struct {
 	AccountID string `path:"account_id"`
@@ -37,6 +42,26 @@ type GetOpenPositionResponse struct {
 		LastDayPrice           decimal.Decimal `json:"lastday_price"`
 		ChangeToday            decimal.Decimal `json:"change_today"`
 	} `json:"usd"`
+	// Contract is parsed from the symbol of option positions. It is nil for other positions and for option
+	// positions whose symbol is not an OCC option symbol. It is not encoded, so it is dropped when the position
+	// is marshalled and parsed again from the symbol when it is unmarshalled.
+	Contract *OptionSymbol `json:"-"`
+}
+
+// UnmarshalJSON decodes the position and parses the contract of option positions.
+func (p *GetOpenPositionResponse) UnmarshalJSON(data []byte) error {
+	type position GetOpenPositionResponse
+	if err := json.Unmarshal(data, (*position)(p)); err != nil {
+		return err
+	}
+	if p.AssetClass != AssetClassUSOption {
+		return nil
+	}
+	// A malformed symbol must not fail the decoding of a whole list of positions.
+	if contract, err := ParseOptionSymbol(p.Symbol); err == nil {
+		p.Contract = &contract
+	}
+	return nil
 }
 
 type ListOpenPositionsParams struct {
@@ -44,3 +69,60 @@ type ListOpenPositionsParams struct {
 }
 
 type ListOpenPositionsResponse []GetOpenPositionResponse
+
+type ClosePositionParams struct {
+	AccountID string `path:"account_id"`
+	// SymbolOrAssetID is the symbol or the asset ID of the position to close.
+	SymbolOrAssetID string `path:"symbol_or_asset_id" validate:"required"`
+	// Quantity is the number of shares to liquidate. It cannot be combined with Percentage.
+	Quantity *decimal.Decimal `query:"qty,omitempty" validate:"excluded_with=Percentage"`
+	// Percentage is the percentage of the position to liquidate. It cannot be combined with Quantity.
+	Percentage *decimal.Decimal `query:"percentage,omitempty" validate:"excluded_with=Quantity"`
+}
+
+type ClosePositionResponse struct {
+	Order
+}
+
+type CloseAllPositionsParams struct {
+	AccountID string `path:"account_id"`
+	// CancelOrders cancels all open orders before liquidating the positions.
+	CancelOrders *bool `query:"cancel_orders,omitempty"`
+}
+
+type CloseAllPositionsResponse = []ClosePositionResult
+
+// ClosePositionResult is the outcome of the liquidation of a single position within a close-all request.
+type ClosePositionResult struct {
+	Symbol string `json:"symbol"`
+	// Status is the HTTP status code of the liquidation of this position.
+	Status int `json:"status"`
+	// Order is the liquidation order decoded from the body. It is only set on success.
+	Order *Order `json:"-"`
+	// Body is the error payload on failure. On success, it is only kept if it cannot be decoded as an order.
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// UnmarshalJSON decodes the result and the liquidation order of successful results.
+// A body that cannot be decoded as an order is kept as is rather than failing the whole response,
+// since the position has been liquidated anyway.
+func (r *ClosePositionResult) UnmarshalJSON(data []byte) error {
+	type result ClosePositionResult
+	if err := json.Unmarshal(data, (*result)(r)); err != nil {
+		return err
+	}
+	if !r.IsSuccess() || len(r.Body) == 0 {
+		return nil
+	}
+	order := &Order{}
+	if err := json.Unmarshal(r.Body, order); err == nil {
+		r.Order = order
+		r.Body = nil
+	}
+	return nil
+}
+
+// IsSuccess reports whether the liquidation order was created.
+func (r ClosePositionResult) IsSuccess() bool {
+	return r.Status >= http.StatusOK && r.Status < http.StatusMultipleChoices
+}
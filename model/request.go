@@ -18,6 +18,21 @@ type RequestOptions struct {
 
 	// Trace enables request tracing.
 	Trace bool
+
+	// IdempotencyKey marks a non-idempotent request (e.g. POST) as safe to retry.
+	IdempotencyKey string
+
+	// Prefetch enables fetching the next page in the background when iterating over paginated results.
+	Prefetch bool
+
+	// NoRetry disables retries for a request that must not be repeated, whatever its HTTP method.
+	NoRetry bool
+}
+
+// IdempotentRequest is implemented by request bodies that carry a client-supplied idempotency key.
+// Requests with such a body are safe to retry even if their HTTP method is not idempotent.
+type IdempotentRequest interface {
+	IdempotencyKey() string
 }
 
 // RequestOption changes the configuration of RequestOptions.
@@ -58,3 +73,24 @@ func WithTrace(trace bool) RequestOption {
 		o.Trace = trace
 	}
 }
+
+// IdempotencyKey sets a client-supplied idempotency key as an option.
+func IdempotencyKey(key string) RequestOption {
+	return func(o *RequestOptions) {
+		o.IdempotencyKey = key
+	}
+}
+
+// WithPrefetch enables or disables fetching the next page in advance when iterating over paginated results.
+func WithPrefetch(prefetch bool) RequestOption {
+	return func(o *RequestOptions) {
+		o.Prefetch = prefetch
+	}
+}
+
+// NoRetry makes a single attempt at the request, even if the retry policy would repeat it.
+func NoRetry() RequestOption {
+	return func(o *RequestOptions) {
+		o.NoRetry = true
+	}
+}
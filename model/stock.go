@@ -18,11 +18,51 @@ type GetLatestQuotesResponse struct {
 }
 
 type Quote struct {
-	AskPrice  decimal.Decimal `json:"ap"`
-	AskSize   uint64          `json:"as"`
-	BidPrice  decimal.Decimal `json:"bp"`
-	BidSize   uint64          `json:"bs"`
-	Timestamp time.Time       `json:"t"`
+	AskPrice    decimal.Decimal  `json:"ap"`
+	AskSize     uint64           `json:"as"`
+	AskExchange string           `json:"ax"`
+	BidPrice    decimal.Decimal  `json:"bp"`
+	BidSize     uint64           `json:"bs"`
+	BidExchange string           `json:"bx"`
+	Conditions  []QuoteCondition `json:"c"`
+	Tape        Tape             `json:"z"`
+	Timestamp   time.Time        `json:"t"`
+}
+
+// QuoteCondition is a quote condition code attached to a quote by the SIP.
+type QuoteCondition string
+
+const (
+	QuoteConditionRegularTwoSidedOpen QuoteCondition = "R"
+	QuoteConditionSlowQuoteOnAskSide  QuoteCondition = "A"
+	QuoteConditionSlowQuoteOnBidSide  QuoteCondition = "B"
+	QuoteConditionClosing             QuoteCondition = "C"
+	QuoteConditionOpeningQuote        QuoteCondition = "O"
+	QuoteConditionTradingHalt         QuoteCondition = "H"
+)
+
+// SymbolQuote is a quote along with the symbol it was reported for.
+type SymbolQuote struct {
+	Symbol string
+	Quote
+}
+
+type GetHistoricalQuotesParams struct {
+	Symbols   string     `query:"symbols,required"`
+	Start     time.Time  `query:"start" validate:"required"`
+	End       *time.Time `query:"end,omitempty"`
+	Limit     *int       `query:"limit,omitempty"`
+	AsOf      *string    `query:"asof,omitempty"`
+	Feed      *string    `query:"feed,omitempty"`
+	Currency  *string    `query:"currency,omitempty"`
+	Sort      *string    `query:"sort,omitempty"`
+	PageToken *string    `query:"page_token,omitempty"`
+}
+
+type GetHistoricalQuotesResponse struct {
+	Quotes        map[string][]Quote `json:"quotes"`
+	NextPageToken string             `json:"next_page_token"`
+	Currency      string             `json:"currency"`
 }
 
 type GetLatestTradesParams struct {
@@ -36,11 +76,91 @@ type GetLatestTradesResponse struct {
 	Currency string                 `json:"currency"`
 }
 
-type LatestTrade struct {
-	Price     decimal.Decimal `json:"p"`
-	Size      uint64          `json:"s"`
-	Exchange  string          `json:"x"`
-	Timestamp time.Time       `json:"t"`
+// LatestTrade is the latest trade of a symbol.
+type LatestTrade = Trade
+
+type Trade struct {
+	ID         int64            `json:"i"`
+	Price      decimal.Decimal  `json:"p"`
+	Size       uint64           `json:"s"`
+	Exchange   string           `json:"x"`
+	Conditions []TradeCondition `json:"c"`
+	Tape       Tape             `json:"z"`
+	Timestamp  time.Time        `json:"t"`
+}
+
+// TradeCondition is a sale condition code attached to a trade by the SIP.
+// The meaning of some codes depends on the tape, see https://docs.alpaca.markets/docs/market-data-faq.
+type TradeCondition string
+
+const (
+	TradeConditionRegularSale               TradeCondition = "@"
+	TradeConditionCashSale                  TradeCondition = "C"
+	TradeConditionIntermarketSweep          TradeCondition = "F"
+	TradeConditionPriceVariationTrade       TradeCondition = "H"
+	TradeConditionOddLotTrade               TradeCondition = "I"
+	TradeConditionMarketCenterOfficialClose TradeCondition = "M"
+	TradeConditionNextDay                   TradeCondition = "N"
+	TradeConditionMarketCenterOpeningTrade  TradeCondition = "O"
+	TradeConditionPriorReferencePrice       TradeCondition = "P"
+	TradeConditionMarketCenterOfficialOpen  TradeCondition = "Q"
+	TradeConditionSeller                    TradeCondition = "R"
+	TradeConditionFormT                     TradeCondition = "T"
+	TradeConditionExtendedHoursSoldOOS      TradeCondition = "U"
+	TradeConditionContingentTrade           TradeCondition = "V"
+	TradeConditionCrossTrade                TradeCondition = "X"
+	TradeConditionSoldOutOfSequence         TradeCondition = "Z"
+	TradeConditionDerivativelyPriced        TradeCondition = "4"
+	TradeConditionReopeningTrade            TradeCondition = "5"
+	TradeConditionClosingTrade              TradeCondition = "6"
+	TradeConditionQualifiedContingentTrade  TradeCondition = "7"
+)
+
+// Tape is the consolidated tape a trade or quote was reported to.
+type Tape string
+
+const (
+	// TapeA covers securities listed on the NYSE.
+	TapeA Tape = "A"
+	// TapeB covers securities listed on NYSE Arca, Cboe and other regional exchanges.
+	TapeB Tape = "B"
+	// TapeC covers securities listed on Nasdaq.
+	TapeC Tape = "C"
+)
+
+type GetHistoricalTradesParams struct {
+	Symbols   string     `query:"symbols,required"`
+	Start     time.Time  `query:"start" validate:"required"`
+	End       *time.Time `query:"end,omitempty"`
+	Limit     *int       `query:"limit,omitempty"`
+	AsOf      *string    `query:"asof,omitempty"`
+	Feed      *string    `query:"feed,omitempty"`
+	Currency  *string    `query:"currency,omitempty"`
+	Sort      *string    `query:"sort,omitempty"`
+	PageToken *string    `query:"page_token,omitempty"`
+}
+
+type GetHistoricalTradesResponse struct {
+	Trades        map[string][]Trade `json:"trades"`
+	NextPageToken string             `json:"next_page_token"`
+	Currency      string             `json:"currency"`
+}
+
+// SymbolTrade is a trade along with the symbol it was reported for.
+type SymbolTrade struct {
+	Symbol string
+	Trade
+}
+
+type GetLatestBarsParams struct {
+	Symbols  string  `query:"symbols,required"`
+	Feed     *string `query:"feed,omitempty"`
+	Currency *string `query:"currency,omitempty"`
+}
+
+type GetLatestBarsResponse struct {
+	Bars     map[string]Bar `json:"bars"`
+	Currency string         `json:"currency"`
 }
 
 type GetSnapshotsParams struct {
@@ -88,9 +208,57 @@ type Bar struct {
 	Close                      decimal.Decimal `json:"c"`
 	Volume                     uint64          `json:"v"`
 	VolumeWeightedAveragePrice decimal.Decimal `json:"vw"`
+	TradeCount                 uint64          `json:"n"`
 	Timestamp                  time.Time       `json:"t"`
 }
 
 type StreamStockUpdatesParams struct {
 	Symbols []string `json:"symbols"`
 }
+
+// TradingStatus is a trading status message, such as a halt or a resumption, for a symbol.
+type TradingStatus struct {
+	Symbol     string
+	StatusCode TradingStatusCode
+	StatusMsg  string
+	ReasonCode string
+	ReasonMsg  string
+	Tape       Tape
+	Timestamp  time.Time
+}
+
+// TradingStatusCode is the trading status code of a symbol.
+// Tapes A and B (CTS) and tape C (UTDF) use different codes.
+type TradingStatusCode string
+
+const (
+	// UTDF codes.
+	TradingStatusHalt                TradingStatusCode = "H"
+	TradingStatusQuotationResumption TradingStatusCode = "Q"
+	TradingStatusTradingResumption   TradingStatusCode = "T"
+	TradingStatusVolatilityPause     TradingStatusCode = "P"
+
+	// CTS codes.
+	TradingStatusCTSHalt       TradingStatusCode = "2"
+	TradingStatusCTSResumption TradingStatusCode = "3"
+)
+
+// IsHalted reports whether trading of the symbol is stopped.
+func (s TradingStatus) IsHalted() bool {
+	switch s.StatusCode {
+	case TradingStatusHalt, TradingStatusVolatilityPause, TradingStatusCTSHalt:
+		return true
+	default:
+		return false
+	}
+}
+
+// LULD is a limit up-limit down price band of a symbol.
+type LULD struct {
+	Symbol         string
+	LimitUpPrice   decimal.Decimal
+	LimitDownPrice decimal.Decimal
+	Indicator      string
+	Tape           Tape
+	Timestamp      time.Time
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Transfer represents a movement of cash between an account and a linked bank.
+type Transfer struct {
+	ID                    uuid.UUID         `json:"id"`
+	AccountID             uuid.UUID         `json:"account_id"`
+	RelationshipID        *uuid.UUID        `json:"relationship_id"`
+	BankID                *uuid.UUID        `json:"bank_id"`
+	Type                  TransferType      `json:"type"`
+	Status                TransferStatus    `json:"status"`
+	Reason                *string           `json:"reason"`
+	Amount                decimal.Decimal   `json:"amount"`
+	RequestedAmount       *decimal.Decimal  `json:"requested_amount"`
+	InstantAmount         *decimal.Decimal  `json:"instant_amount"`
+	Fee                   *decimal.Decimal  `json:"fee"`
+	FeePaymentMethod      *FeePaymentMethod `json:"fee_payment_method"`
+	Direction             TransferDirection `json:"direction"`
+	AdditionalInformation *string           `json:"additional_information"`
+	HoldUntil             *time.Time        `json:"hold_until"`
+	ExpiresAt             *time.Time        `json:"expires_at"`
+	CreatedAt             time.Time         `json:"created_at"`
+	UpdatedAt             time.Time         `json:"updated_at"`
+}
+
+type TransferType string
+
+const (
+	TransferTypeACH  TransferType = "ach"
+	TransferTypeWire TransferType = "wire"
+)
+
+type TransferDirection string
+
+const (
+	// TransferDirectionIncoming moves cash from the bank to the account.
+	TransferDirectionIncoming TransferDirection = "INCOMING"
+	// TransferDirectionOutgoing moves cash from the account to the bank.
+	TransferDirectionOutgoing TransferDirection = "OUTGOING"
+)
+
+type TransferTiming string
+
+const (
+	TransferTimingImmediate TransferTiming = "immediate"
+)
+
+type FeePaymentMethod string
+
+const (
+	// FeePaymentMethodUser deducts the fee from the transfer amount.
+	FeePaymentMethodUser FeePaymentMethod = "user"
+	// FeePaymentMethodInvoice bills the fee to the correspondent.
+	FeePaymentMethodInvoice FeePaymentMethod = "invoice"
+)
+
+type CreateTransferParams struct {
+	AccountID string `path:"account_id"`
+}
+
+// CreateTransferRequest creates an ACH transfer (with RelationshipID) or a wire transfer (with BankID).
+type CreateTransferRequest struct {
+	TransferType          TransferType      `json:"transfer_type"`
+	RelationshipID        *uuid.UUID        `json:"relationship_id,omitempty"`
+	BankID                *uuid.UUID        `json:"bank_id,omitempty"`
+	Amount                decimal.Decimal   `json:"amount"`
+	Direction             TransferDirection `json:"direction"`
+	Timing                TransferTiming    `json:"timing"`
+	FeePaymentMethod      *FeePaymentMethod `json:"fee_payment_method,omitempty"`
+	AdditionalInformation *string           `json:"additional_information,omitempty"`
+}
+
+type CreateTransferResponse struct {
+	Transfer
+}
+
+type ListTransfersParams struct {
+	AccountID string             `path:"account_id"`
+	Direction *TransferDirection `query:"direction,omitempty"`
+	Limit     *int               `query:"limit,omitempty"`
+	Offset    *int               `query:"offset,omitempty"`
+}
+
+type ListTransfersResponse = []Transfer
+
+type CancelTransferParams struct {
+	AccountID  string `path:"account_id"`
+	TransferID string `path:"transfer_id"`
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Watchlist struct {
+	ID        uuid.UUID `json:"id"`
+	AccountID uuid.UUID `json:"account_id"`
+	Name      string    `json:"name"`
+	// Assets is only set when a single watchlist is returned.
+	Assets    []WatchlistAsset `json:"assets"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+// WatchlistAsset is an entry of a watchlist.
+type WatchlistAsset struct {
+	Asset
+}
+
+type CreateWatchlistParams struct {
+	AccountID string `path:"account_id"`
+}
+
+type CreateWatchlistRequest struct {
+	Name    string   `json:"name"`
+	Symbols []string `json:"symbols,omitempty"`
+}
+
+type CreateWatchlistResponse struct {
+	Watchlist
+}
+
+type ListWatchlistsParams struct {
+	AccountID string `path:"account_id"`
+}
+
+type ListWatchlistsResponse = []Watchlist
+
+type GetWatchlistParams struct {
+	AccountID   string `path:"account_id"`
+	WatchlistID string `path:"watchlist_id"`
+}
+
+type GetWatchlistResponse struct {
+	Watchlist
+}
+
+type UpdateWatchlistParams struct {
+	AccountID   string `path:"account_id"`
+	WatchlistID string `path:"watchlist_id"`
+}
+
+// UpdateWatchlistRequest replaces the name and the symbols of the watchlist.
+type UpdateWatchlistRequest struct {
+	Name    string   `json:"name"`
+	Symbols []string `json:"symbols"`
+}
+
+type UpdateWatchlistResponse struct {
+	Watchlist
+}
+
+type DeleteWatchlistParams struct {
+	AccountID   string `path:"account_id"`
+	WatchlistID string `path:"watchlist_id"`
+}
+
+type AddWatchlistSymbolParams struct {
+	AccountID   string `path:"account_id"`
+	WatchlistID string `path:"watchlist_id"`
+}
+
+type AddWatchlistSymbolRequest struct {
+	Symbol string `json:"symbol"`
+}
+
+type AddWatchlistSymbolResponse struct {
+	Watchlist
+}
+
+type RemoveWatchlistSymbolParams struct {
+	AccountID   string `path:"account_id"`
+	WatchlistID string `path:"watchlist_id"`
+	Symbol      string `path:"symbol"`
+}
+
+type RemoveWatchlistSymbolResponse struct {
+	Watchlist
+}
@@ -0,0 +1,79 @@
+package sse
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// MaxLineSize is the maximum size of a single line in the event stream.
+const MaxLineSize = 1 << 20
+
+// Decoder reads events from an event stream.
+type Decoder struct {
+	scanner *bufio.Scanner
+	parser  *Parser
+}
+
+// NewDecoder returns a decoder reading from r.
+func NewDecoder(r io.Reader) *Decoder {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, MaxLineSize)
+	scanner.Split((&lineSplitter{}).scanLines)
+	return &Decoder{
+		scanner: scanner,
+		parser:  NewParser(),
+	}
+}
+
+// Decode returns the next event from the stream.
+// It returns io.EOF when the stream ends. An event that is not terminated by a blank line is discarded.
+func (d *Decoder) Decode() (*Event, error) {
+	for d.scanner.Scan() {
+		if e, ok := d.parser.ParseLine(d.scanner.Bytes()); ok {
+			return e, nil
+		}
+	}
+	if err := d.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// lineSplitter splits an event stream on CRLF, LF or CR line terminators.
+// A line ending with CR is returned right away, and a LF following it is skipped once it arrives, so that
+// streams using CR-only terminators are not held back until more bytes are read.
+type lineSplitter struct {
+	skipLF bool
+}
+
+// scanLines is a bufio.SplitFunc.
+func (s *lineSplitter) scanLines(data []byte, atEOF bool) (int, []byte, error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if s.skipLF && len(data) > 0 {
+		s.skipLF = false
+		if data[0] == '\n' {
+			return 1, nil, nil
+		}
+	}
+	if i := bytes.IndexAny(data, "\r\n"); i >= 0 {
+		if data[i] == '\n' {
+			return i + 1, data[:i], nil
+		}
+		if i+1 < len(data) {
+			if data[i+1] == '\n' {
+				return i + 2, data[:i], nil
+			}
+			return i + 1, data[:i], nil
+		}
+		// The CR may be followed by a LF that has not been read yet.
+		s.skipLF = true
+		return i + 1, data[:i], nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
@@ -6,19 +6,29 @@ import (
 )
 
 const (
+	FieldNameEvent = "event"
 	FieldNameData  = "data"
+	FieldNameID    = "id"
 	FieldNameRetry = "retry"
+
+	// DefaultEventType is the type of events that do not specify one.
+	DefaultEventType = "message"
 )
 
 // Event represents an individual event from the event stream.
-// This is the simplest form of an event that only contains the data that is expected to be a single line of
-// JSON data, a comment or the retry indicator.
+// It is either a dispatched event with its type, last event ID and data, a comment or the retry indicator.
 type Event struct {
 	timestamp time.Time
 
+	// ID is the last event ID of the stream at the time the event was dispatched.
+	ID string
+	// Type is the event type, which defaults to DefaultEventType.
+	Type string
+	// Data is the event payload. Multi-line data is joined with newlines.
 	Data    []byte
 	Comment string
-	Retry   int
+	// Retry is the reconnection time in milliseconds requested by the server.
+	Retry int
 }
 
 func (e *Event) IsComment() bool {
@@ -2,44 +2,140 @@ package sse
 
 import (
 	"bytes"
-	"fmt"
 	"strconv"
+	"time"
 )
 
-type Parser struct{}
+var bom = []byte("\xEF\xBB\xBF")
+
+// Parser is a stateful event stream parser following the WHATWG server-sent events specification.
+// Lines are fed one at a time and an event is dispatched on every blank line.
+//
+// See https://html.spec.whatwg.org/multipage/server-sent-events.html#event-stream-interpretation.
+type Parser struct {
+	started bool
+
+	data        bytes.Buffer
+	hasData     bool
+	eventType   string
+	lastEventID string
+}
 
 func NewParser() *Parser {
 	return &Parser{}
 }
 
-func (p *Parser) ParseEvent(data []byte) (*Event, error) {
-	if len(bytes.TrimSpace(data)) == 0 {
-		return &Event{}, nil
+// ParseLine processes a single line of the stream and returns an event when one is dispatched.
+// Trailing line terminators (CRLF, LF or CR) are ignored and a leading BOM is skipped on the first line.
+//
+// Besides dispatched events, comments are returned as comment events, and a valid retry field is returned
+// right away as an event carrying only the retry value, so that callers can update their reconnection time
+// even if the connection drops before the next blank line.
+func (p *Parser) ParseLine(line []byte) (*Event, bool) {
+	line = trimLineTerminator(line)
+	if !p.started {
+		p.started = true
+		line = bytes.TrimPrefix(line, bom)
 	}
-	if data[0] == ':' {
-		return NewEvent(nil, data[1:], 0), nil
+
+	if len(line) == 0 {
+		return p.dispatch()
+	}
+	if line[0] == ':' {
+		return NewEvent(nil, line[1:], 0), true
 	}
+
 	var (
-		field = data
+		field = line
 		value []byte
 	)
-	if i := bytes.IndexRune(data, ':'); i != -1 {
-		field = data[:i]
-		value = data[i+1:]
+	if i := bytes.IndexByte(line, ':'); i != -1 {
+		field = line[:i]
+		value = line[i+1:]
 		if len(value) != 0 && value[0] == ' ' {
 			value = value[1:]
 		}
 	}
 	switch string(field) {
+	case FieldNameEvent:
+		p.eventType = string(value)
 	case FieldNameData:
-		return NewEvent(value, nil, 0), nil
+		if p.hasData {
+			p.data.WriteByte('\n')
+		}
+		p.data.Write(value)
+		p.hasData = true
+	case FieldNameID:
+		if bytes.IndexByte(value, 0) == -1 {
+			p.lastEventID = string(value)
+		}
 	case FieldNameRetry:
-		i, err := strconv.Atoi(string(value))
-		if err != nil {
-			return nil, fmt.Errorf("parsing retry field: %w", err)
+		if retry, ok := parseRetry(value); ok {
+			return NewEvent(nil, nil, retry), true
 		}
-		return NewEvent(nil, nil, i), nil
 	default:
-		return nil, fmt.Errorf("unknown or unexpected field: %s", field)
+		// Unknown fields are ignored.
+	}
+	return nil, false
+}
+
+// ParseEvent processes a single line of the stream like ParseLine and never returns an error.
+//
+// Behavior change: data, event and id lines no longer yield an event each, and unknown fields are no longer
+// errors. Data is buffered until the blank line that dispatches the event, and every line that does not
+// dispatch one returns an empty event, as blank lines did before. Callers must skip events for which
+// IsEmpty reports true instead of treating each returned event as a message.
+//
+// Deprecated: Use ParseLine, which reports whether an event was dispatched.
+func (p *Parser) ParseEvent(data []byte) (*Event, error) {
+	if e, ok := p.ParseLine(data); ok {
+		return e, nil
+	}
+	return &Event{}, nil
+}
+
+// dispatch returns the buffered event and resets the buffers.
+// The last event ID is kept for the following events.
+func (p *Parser) dispatch() (*Event, bool) {
+	defer func() {
+		p.data.Reset()
+		p.hasData = false
+		p.eventType = ""
+	}()
+
+	if !p.hasData {
+		return nil, false
+	}
+
+	e := &Event{
+		ID:        p.lastEventID,
+		Type:      p.eventType,
+		Data:      bytes.Clone(p.data.Bytes()),
+		timestamp: time.Now(),
+	}
+	if e.Type == "" {
+		e.Type = DefaultEventType
+	}
+	return e, true
+}
+
+func trimLineTerminator(line []byte) []byte {
+	line = bytes.TrimSuffix(line, []byte("\n"))
+	return bytes.TrimSuffix(line, []byte("\r"))
+}
+
+func parseRetry(value []byte) (int, bool) {
+	if len(value) == 0 {
+		return 0, false
+	}
+	for _, b := range value {
+		if b < '0' || b > '9' {
+			return 0, false
+		}
+	}
+	retry, err := strconv.Atoi(string(value))
+	if err != nil {
+		return 0, false
 	}
+	return retry, true
 }
@@ -0,0 +1,230 @@
+package sse
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// decoded is the comparable part of an Event.
+type decoded struct {
+	ID      string
+	Type    string
+	Data    string
+	Comment string
+	Retry   int
+}
+
+func toDecoded(e *Event) decoded {
+	return decoded{ID: e.ID, Type: e.Type, Data: string(e.Data), Comment: e.Comment, Retry: e.Retry}
+}
+
+func TestDecoder(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []decoded
+	}{
+		{
+			name:  "LF",
+			input: "data: hello\n\n",
+			want:  []decoded{{Type: DefaultEventType, Data: "hello"}},
+		},
+		{
+			name:  "CRLF",
+			input: "data: hello\r\n\r\ndata: world\r\n\r\n",
+			want:  []decoded{{Type: DefaultEventType, Data: "hello"}, {Type: DefaultEventType, Data: "world"}},
+		},
+		{
+			name:  "CR",
+			input: "data: hello\r\rdata: world\r\r",
+			want:  []decoded{{Type: DefaultEventType, Data: "hello"}, {Type: DefaultEventType, Data: "world"}},
+		},
+		{
+			name:  "BOM",
+			input: "\xEF\xBB\xBFdata: hello\n\n",
+			want:  []decoded{{Type: DefaultEventType, Data: "hello"}},
+		},
+		{
+			name:  "multi-line data",
+			input: "data: first\ndata\ndata: third\n\n",
+			want:  []decoded{{Type: DefaultEventType, Data: "first\n\nthird"}},
+		},
+		{
+			name:  "only the first space is stripped",
+			input: "data:no space\n\ndata:  two spaces\n\n",
+			want:  []decoded{{Type: DefaultEventType, Data: "no space"}, {Type: DefaultEventType, Data: " two spaces"}},
+		},
+		{
+			name:  "event and id",
+			input: "event: update\nid: 42\ndata: x\n\n",
+			want:  []decoded{{ID: "42", Type: "update", Data: "x"}},
+		},
+		{
+			name:  "id is kept and event type is reset",
+			input: "event: update\nid: 1\ndata: a\n\ndata: b\n\n",
+			want:  []decoded{{ID: "1", Type: "update", Data: "a"}, {ID: "1", Type: DefaultEventType, Data: "b"}},
+		},
+		{
+			name:  "empty id resets the last event id",
+			input: "id: 1\ndata: a\n\nid:\ndata: b\n\n",
+			want:  []decoded{{ID: "1", Type: DefaultEventType, Data: "a"}, {Type: DefaultEventType, Data: "b"}},
+		},
+		{
+			name:  "id with NUL is ignored",
+			input: "id: 1\ndata: a\n\nid: 2\x003\ndata: b\n\n",
+			want:  []decoded{{ID: "1", Type: DefaultEventType, Data: "a"}, {ID: "1", Type: DefaultEventType, Data: "b"}},
+		},
+		{
+			name:  "unknown fields are ignored",
+			input: "foo: bar\nunknown\ndata: x\n\n",
+			want:  []decoded{{Type: DefaultEventType, Data: "x"}},
+		},
+		{
+			name:  "comment",
+			input: ": keep-alive\n\n",
+			want:  []decoded{{Comment: "keep-alive"}},
+		},
+		{
+			name:  "retry is returned right away",
+			input: "retry: 1500\ndata: x\n",
+			want:  []decoded{{Retry: 1500}},
+		},
+		{
+			name:  "invalid retry is ignored",
+			input: "retry: 1s\nretry:\ndata: x\n\n",
+			want:  []decoded{{Type: DefaultEventType, Data: "x"}},
+		},
+		{
+			name:  "event without data is not dispatched",
+			input: "event: update\nid: 1\n\n\n",
+		},
+		{
+			name:  "unterminated event is discarded",
+			input: "data: x",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDecoder(strings.NewReader(tt.input))
+			var got []decoded
+			for {
+				e, err := d.Decode()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				got = append(got, toDecoded(e))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLine(t *testing.T) {
+	type result struct {
+		event decoded
+		ok    bool
+	}
+	tests := []struct {
+		name  string
+		lines []string
+		want  []result
+	}{
+		{
+			name:  "line terminators are trimmed",
+			lines: []string{"data: a\r\n", "data: b\r", "data: c\n", "\r\n"},
+			want:  []result{{}, {}, {}, {event: decoded{Type: DefaultEventType, Data: "a\nb\nc"}, ok: true}},
+		},
+		{
+			name:  "BOM is only skipped on the first line",
+			lines: []string{"\xEF\xBB\xBFdata: a", "\xEF\xBB\xBFdata: b", ""},
+			want:  []result{{}, {}, {event: decoded{Type: DefaultEventType, Data: "a"}, ok: true}},
+		},
+		{
+			name:  "retry and comments are returned on their line",
+			lines: []string{"data: a", "retry: 100", ": note", ""},
+			want: []result{
+				{},
+				{event: decoded{Retry: 100}, ok: true},
+				{event: decoded{Comment: "note"}, ok: true},
+				{event: decoded{Type: DefaultEventType, Data: "a"}, ok: true},
+			},
+		},
+		{
+			name:  "blank line without data",
+			lines: []string{"", "event: x", ""},
+			want:  []result{{}, {}, {}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewParser()
+			for i, line := range tt.lines {
+				e, ok := p.ParseLine([]byte(line))
+				got := result{ok: ok}
+				if ok {
+					got.event = toDecoded(e)
+				}
+				if got != tt.want[i] {
+					t.Errorf("line %d %q: got %+v, want %+v", i, line, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseEvent(t *testing.T) {
+	p := NewParser()
+	for _, line := range []string{"data: a", "unknown: field"} {
+		e, err := p.ParseEvent([]byte(line))
+		if err != nil || e == nil || !e.IsEmpty() {
+			t.Fatalf("line %q: got %+v, %v, want an empty event", line, e, err)
+		}
+	}
+	e, err := p.ParseEvent(nil)
+	if err != nil || string(e.Data) != "a" {
+		t.Fatalf("got %+v, %v, want the dispatched event", e, err)
+	}
+}
+
+func FuzzParser(f *testing.F) {
+	f.Add([]byte("data: hello\n\n"))
+	f.Add([]byte("data: hello\r\n\r\n"))
+	f.Add([]byte("data: hello\r\rdata: world\r\r"))
+	f.Add([]byte("\xEF\xBB\xBFdata: bom\n\n"))
+	f.Add([]byte("event: update\nid: 1\ndata: first\ndata: second\n\n"))
+	f.Add([]byte("unknown: field\nfoo\ndata: x\nretry: 1000\n\n"))
+	f.Add([]byte(": comment\r\nretry: abc\r\nid: a\x00b\r\n\r\ndata\n\n"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		d := NewDecoder(bytes.NewReader(data))
+		for {
+			e, err := d.Decode()
+			if err != nil {
+				if !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrTooLong) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				break
+			}
+			if e == nil {
+				t.Fatal("nil event without error")
+			}
+		}
+
+		p := NewParser()
+		for _, line := range bytes.Split(data, []byte("\n")) {
+			if _, err := p.ParseEvent(line); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+	})
+}